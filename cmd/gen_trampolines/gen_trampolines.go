@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// Generates the cgo trampolines which allow SVM to call Go import functions (including closures)
+// without an `//export`ed Go function per import.
+//
+// For each supported signature shape (up to `maxParams` `i32`/`i64` params, and either no return,
+// an `i32` return or an `i64` return), `slotsPerShape` distinct C functions are generated.
+// Each one forwards its arguments, along with its unique slot number, to the `svm_import_dispatch`
+// C function, which calls the single exported `svmImportDispatch` Go function, which then calls
+// the Go function bound to that slot.
+
+const (
+	maxParams     = 4
+	slotsPerShape = 16
+)
+
+var out string
+
+func init() {
+	flag.StringVar(&out, "out", "trampolines.go", "output file")
+	flag.Parse()
+}
+
+type valueType struct {
+	code  string // used for naming
+	cType string
+	name  string // the Go `ValueType` constant
+}
+
+var (
+	i32 = valueType{"i", "int32_t", "TypeI32"}
+	i64 = valueType{"l", "int64_t", "TypeI64"}
+)
+
+type shape struct {
+	params  []valueType
+	returns []valueType
+}
+
+func (s shape) name() string {
+	var params, returns string
+	for _, p := range s.params {
+		params += p.code
+	}
+	for _, r := range s.returns {
+		returns += r.code
+	}
+	if params == "" {
+		params = "v"
+	}
+	if returns == "" {
+		returns = "v"
+	}
+	return params + "_" + returns
+}
+
+func shapes() []shape {
+	var paramLists [][]valueType
+	var gen func(prefix []valueType)
+	gen = func(prefix []valueType) {
+		paramLists = append(paramLists, prefix)
+		if len(prefix) == maxParams {
+			return
+		}
+		for _, vt := range []valueType{i32, i64} {
+			gen(append(append([]valueType{}, prefix...), vt))
+		}
+	}
+	gen(nil)
+
+	var res []shape
+	for _, params := range paramLists {
+		for _, returns := range [][]valueType{nil, {i32}, {i64}} {
+			res = append(res, shape{params, returns})
+		}
+	}
+	return res
+}
+
+func main() {
+	all := shapes()
+	buf := &bytes.Buffer{}
+
+	fmt.Fprintf(buf, "// Code generated by gen_trampolines; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package svm\n\n")
+
+	fmt.Fprintf(buf, "// #include <stdint.h>\n")
+	fmt.Fprintf(buf, "//\n")
+	fmt.Fprintf(buf, "// extern int64_t svm_import_dispatch(void *ctx, int slot, int64_t *args, int nargs);\n")
+	fmt.Fprintf(buf, "//\n")
+
+	slot := 0
+	var names []string
+	for _, s := range all {
+		for i := 0; i < slotsPerShape; i++ {
+			name := fmt.Sprintf("svm_trampoline_%s_%d", s.name(), i)
+			names = append(names, name)
+
+			ret := "void"
+			if len(s.returns) == 1 {
+				ret = s.returns[0].cType
+			}
+
+			params := []string{"void *ctx"}
+			args := []string{}
+			for j, p := range s.params {
+				params = append(params, fmt.Sprintf("%s a%d", p.cType, j))
+				args = append(args, fmt.Sprintf("a%d", j))
+			}
+
+			argsDecl := "int64_t *args = 0;"
+			if len(args) > 0 {
+				argsDecl = fmt.Sprintf("int64_t args[%d] = {%s};", len(args), strings.Join(args, ", "))
+			}
+
+			call := fmt.Sprintf("svm_import_dispatch(ctx, %d, args, %d)", slot, len(args))
+			if ret != "void" {
+				call = fmt.Sprintf("return (%s)%s", ret, call)
+			}
+
+			fmt.Fprintf(buf, "// static %s %s(%s) { %s %s; }\n", ret, name, strings.Join(params, ", "), argsDecl, call)
+			slot++
+		}
+	}
+
+	fmt.Fprintf(buf, "//\n")
+	fmt.Fprintf(buf, "// static void *svm_trampolines[] = {\n")
+	for _, name := range names {
+		fmt.Fprintf(buf, "//   (void *)%s,\n", name)
+	}
+	fmt.Fprintf(buf, "// };\n")
+	fmt.Fprintf(buf, "//\n")
+	fmt.Fprintf(buf, "// static void *svm_trampoline(int slot) { return svm_trampolines[slot]; }\n")
+	fmt.Fprintf(buf, "import \"C\"\n\n")
+	fmt.Fprintf(buf, "import \"unsafe\"\n\n")
+
+	fmt.Fprintf(buf, "// trampolineSlotsPerShape is the number of trampolines generated per signature shape,\n")
+	fmt.Fprintf(buf, "// which is the max number of closure import functions sharing the same shape at any given time.\n")
+	fmt.Fprintf(buf, "const trampolineSlotsPerShape = %d\n\n", slotsPerShape)
+
+	fmt.Fprintf(buf, "// trampolineShapes lists the supported signature shapes, ordered by their slots range.\n")
+	fmt.Fprintf(buf, "var trampolineShapes = []trampolineShape{\n")
+	for _, s := range all {
+		var params, returns []string
+		for _, p := range s.params {
+			params = append(params, p.name)
+		}
+		for _, r := range s.returns {
+			returns = append(returns, r.name)
+		}
+		fmt.Fprintf(buf, "\t{ValueTypes{%s}, ValueTypes{%s}},\n", strings.Join(params, ", "), strings.Join(returns, ", "))
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func cTrampoline(slot int) unsafe.Pointer {\n")
+	fmt.Fprintf(buf, "\treturn C.svm_trampoline(C.int(slot))\n")
+	fmt.Fprintf(buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format the generated code: %v", err)
+	}
+
+	if err := ioutil.WriteFile(out, src, 0644); err != nil {
+		log.Fatalf("failed to write `%v`: %v", out, err)
+	}
+}
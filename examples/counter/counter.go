@@ -1,38 +1,30 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"go-svm/svm"
+	"go-svm/svm/wat"
 	"io/ioutil"
 	"unsafe"
 )
 
-// Declare `inc` and `get` C function signatures in the cgo preamble.
-// Doing so is required for SVM to be able to invoke their Go implementation.
-
-// #include <stdlib.h>
-//
-// extern void inc(void *ctx, int value);
-// extern int get(void *ctx);
-import "C"
-
 // Define `inc` and `get` Go implementation.
 // The first argument is the runtime context, and must be included by all import functions.
-// Notice the `//export` comment which is the way cgo uses to map Go code to C code.
+// Since SVM calls them through a generic trampoline, they can be any Go functions or closures,
+// and don't need to be `//export`ed via cgo.
 
-//export inc
 func inc(ctx unsafe.Pointer, value int32) {
 	// SVM import function can access a closure variable,
 	closure.value += value
 
-	// or the runtime's provided host object pointer.
-	host := (*counter)(svm.InstanceContextHostGet(ctx))
+	// or the runtime's provided host value.
+	host := hostFromContext(ctx)
 	host.value += value
 }
 
-//export get
 func get(ctx unsafe.Pointer) int32 {
-	host := (*counter)(svm.InstanceContextHostGet(ctx))
+	host := hostFromContext(ctx)
 
 	// Host and closure variable should be synced.
 	if host.value != closure.value {
@@ -46,16 +38,23 @@ type counter struct {
 	value int32
 }
 
-var closure counter
+func hostFromContext(ctx unsafe.Pointer) *counter {
+	host, ok := svm.HostFromContext(ctx)
+	if !ok {
+		panic("host value is missing")
+	}
+
+	return host.(*counter)
+}
 
-var host counter
+var closure counter
 
 func main() {
 	// 1) Initialize runtime.
 	ib := svm.NewImportsBuilder()
-	ib, err := ib.AppendFunction("inc", inc, C.inc)
+	ib, err := ib.AppendFunction("inc", inc)
 	noError(err)
-	ib, err = ib.AppendFunction("get", get, C.get)
+	ib, err = ib.AppendFunction("get", get)
 	noError(err)
 	imports, err := ib.Build()
 	noError(err)
@@ -65,235 +64,85 @@ func main() {
 	noError(err)
 	defer kv.Free()
 
-	host := unsafe.Pointer(&host)
+	host := &counter{}
+
+	hostCtx, err := svm.NewHostCtx().Encode()
+	noError(err)
 
 	runtime, err := svm.NewRuntimeBuilder().
 		WithImports(imports).
 		WithMemKVStore(kv).
-		WithHost(host).
+		WithHostValue(host).
+		WithExecOptions(svm.ExecOptions{
+			HostCtx: hostCtx,
+		}).
 		Build()
 	noError(err)
 	defer runtime.Free()
 	fmt.Printf("1) Runtime: %v\n\n", runtime)
 
-	version := 0
-	hostCtx := svm.NewHostCtx().Encode()
-	gasMetering := false
-	gasLimit := uint64(0)
+	// The client encodes and validates the transactions, and keeps the app state.
+	client := svm.NewClient(runtime)
 
 	// 2) Deploy Template.
-	// TODO: add on-the-fly wat2wasm translation
-	code, err := ioutil.ReadFile("counter_template.wasm")
-	dataLayout := svm.DataLayout{4}
+	// The template is compiled on the fly out of its text format. It's the one the svm package
+	// tests use, so the example is run from its directory (see `just example`).
+	src, err := ioutil.ReadFile("../../svm/testdata/counter_template.wat")
+	noError(err)
+	code, err := wat.Compile(src)
+	noError(err)
+	// The storage holds a single `u32` var (var #0), used via `svm.get32` and `svm.set32`.
+	vars, err := svm.NewDataLayoutBuilder().U32("counter").Build()
+	noError(err)
+	template, err := client.Deploy(code, vars.DataLayout(), "name")
 	noError(err)
-	name := "name"
-	author := svm.Address{}
-	deployTemplateResult, err := deployTemplate(
-		runtime,
-		code,
-		dataLayout,
-		version,
-		name,
-		author,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
-	fmt.Printf("2) %v\n", deployTemplateResult)
+	fmt.Printf("2) %v\n", template.DeployResult)
 
 	// 3) Spawn App.
-	creator := svm.Address{}
-	spawnAppResult, err := spawnApp(
-		runtime,
-		version,
-		deployTemplateResult.TemplateAddr,
-		uint16(0),
-		[]byte(nil),
-		svm.Values{svm.I32(5)},
-		creator,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
-	fmt.Printf("3) %v\n", spawnAppResult)
+	// The functions are called by name, as described by the template ABI,
+	// which is derived from the template exports.
+	app, err := template.SpawnByName("storage_inc", svm.I32(5))
+	noError(err)
+	fmt.Printf("3) %v\n", app.SpawnResult)
 
 	// 4) Exec App
 	// 4.0) Storage value increment.
-	execAppResult, err := execApp(
-		runtime,
-		version,
-		spawnAppResult.AppAddr,
-		uint16(0),
-		[]byte(nil),
-		svm.Values{svm.I32(5)},
-		spawnAppResult.InitialState,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
+	execAppResult, err := app.CallByName("storage_inc", svm.I32(5))
+	noError(err)
 	fmt.Printf("4.0) %v\n", execAppResult)
 
 	// 4.1) Storage value get.
-	execAppResult, err = execApp(
-		runtime,
-		version,
-		spawnAppResult.AppAddr,
-		uint16(1),
-		[]byte(nil),
-		svm.Values(nil),
-		execAppResult.NewState,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
-	fmt.Printf("4.1) %v\n", execAppResult)
+	// It only reads the storage, so it is run as a query, which commits nothing.
+	// Queries require the `svm-dep` shim (the `svmdep` build tag), so it is called otherwise.
+	queryAppResult, err := app.QueryByName("storage_get")
+	if errors.Is(err, svm.ErrUnsupported) {
+		execAppResult, err = app.CallByName("storage_get")
+		noError(err)
+		fmt.Printf("4.1) %v\n", execAppResult)
+	} else {
+		noError(err)
+		fmt.Printf("4.1) %v\n", queryAppResult)
+	}
 
 	// 4.2) Host import function value increment.
-	execAppResult, err = execApp(
-		runtime,
-		version,
-		spawnAppResult.AppAddr,
-		uint16(2),
-		[]byte(nil),
-		svm.Values{svm.I32(25)},
-		execAppResult.NewState,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
+	execAppResult, err = app.CallByName("host_inc", svm.I32(25))
+	noError(err)
 	fmt.Printf("4.2) %v\n", execAppResult)
 
 	// 4.3) Host import function value get.
-	execAppResult, err = execApp(
-		runtime,
-		version,
-		spawnAppResult.AppAddr,
-		uint16(3),
-		[]byte(nil),
-		svm.Values(nil),
-		execAppResult.NewState,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
+	execAppResult, err = app.CallByName("host_get")
+	noError(err)
 	fmt.Printf("4.3) %v\n", execAppResult)
-}
-
-func deployTemplate(
-	runtime svm.Runtime,
-	code []byte,
-	dataLayout svm.DataLayout,
-	version int,
-	name string,
-	author svm.Address,
-	hostCtx []byte,
-	gasMetering bool,
-	gasLimit uint64,
-) (*svm.DeployTemplateResult, error) {
-	appTemplate, err := svm.EncodeAppTemplate(
-		version,
-		name,
-		code,
-		dataLayout,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	if err = svm.ValidateTemplate(runtime, appTemplate); err != nil {
-		return nil, err
-	}
 
-	res, err := svm.DeployTemplate(
-		runtime,
-		appTemplate,
-		author,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-func spawnApp(
-	runtime svm.Runtime,
-	version int,
-	templateAddr svm.Address,
-	ctorIndex uint16,
-	ctorBuffer []byte,
-	ctorArgs svm.Values,
-	creator svm.Address,
-	hostCtx []byte,
-	gasMetering bool,
-	gasLimit uint64,
-) (*svm.SpawnAppResult, error) {
-	spawnApp, err := svm.EncodeSpawnApp(
-		version,
-		templateAddr,
-		ctorIndex,
-		ctorBuffer,
-		ctorArgs,
-	)
-	if err != nil {
-		return nil, err
+	// 5) Dump the app storage at its latest state.
+	// It requires the `svm-dep` shim as well.
+	values, err := runtime.DumpStorage(app.Addr, app.State(), vars)
+	if errors.Is(err, svm.ErrUnsupported) {
+		fmt.Printf("5) Storage: %v\n", err)
+		return
 	}
-
-	if err = svm.ValidateApp(runtime, spawnApp); err != nil {
-		return nil, err
-	}
-
-	res, err := svm.SpawnApp(
-		runtime,
-		spawnApp,
-		creator,
-		hostCtx,
-		gasMetering,
-		gasLimit,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-func execApp(
-	runtime svm.Runtime,
-	version int,
-	appAddr svm.Address,
-	funcIndex uint16,
-	funcBuffer []byte,
-	funcArgs svm.Values,
-	appState []byte,
-	hostCtx []byte,
-	gasMetering bool,
-	gasLimit uint64,
-) (*svm.ExecAppResult, error) {
-	appTx, err := svm.EncodeAppTx(
-		version,
-		appAddr,
-		funcIndex,
-		funcBuffer,
-		funcArgs,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	if _, err = svm.ValidateAppTx(runtime, appTx); err != nil {
-		return nil, err
-	}
-
-	execAppResult, err := svm.ExecApp(runtime, appTx, appState, hostCtx, gasMetering, gasLimit)
-	if err != nil {
-		return nil, err
-	}
-
-	return execAppResult, nil
+	noError(err)
+	fmt.Printf("5) Storage: %v\n", values)
 }
 
 func noError(err error) {
@@ -1 +1,259 @@
-// Empty module, so that we can install svm through Cargo more easily.
+// The `svm-dep` shim, built as `libsvm_dep` next to `libsvm_runtime_c_api`.
+// It exposes the few C functions which the go-svm bindings need, but the SVM
+// C API doesn't provide. Their declarations are in `svm/svm_dep.h`.
+//
+// The shim reads the kv-stores created by the SVM C API as its own Rust types, and traps
+// the runtime via the wasmer statics, so the shim and the C API must be one binary,
+// built by one compiler out of one set of sources: `libsvm_dep` exports the SVM C API
+// functions as well, and the go-svm `svmdep` builds link it instead of `libsvm_runtime_c_api`.
+
+mod overlay;
+
+use std::cell::RefCell;
+use std::ffi::c_void;
+use std::rc::Rc;
+
+use svm_common::{Address, State};
+use svm_runtime_c_api::{svm_byte_array, svm_result_t};
+use svm_storage2::app::AppStorage;
+use svm_storage2::kv::StatefulKV;
+use svm_storage2::layout::{DataLayout, VarId};
+use wasmer_runtime_core::backend::RunnableModule;
+use wasmer_runtime_core::vm::Ctx;
+
+use overlay::OverlayKV;
+
+// Exports the `#[no_mangle]` functions of the SVM C API out of this cdylib.
+pub use svm_runtime_c_api::*;
+
+/// The in-memory kv-store behind the `kv` pointers of the SVM C API.
+///
+/// It is assumed that `svm_memory_kv_create` (`svm-runtime-c-api`, at the rev pinned in `Cargo.toml`)
+/// hands out a boxed `Rc<RefCell<dyn StatefulKV>>`, which is what `svm_memory_runtime_create` reads
+/// its `kv` as. The C API doesn't document it (`svm.h` types `kv` as `void *`), so it must be checked
+/// whenever the rev is bumped: all the casts of `kv` go through `as_memory_kv`. The layout of the type
+/// itself is the same on both sides, since they're part of the same cdylib.
+type MemoryKV = Rc<RefCell<dyn StatefulKV>>;
+
+unsafe fn as_memory_kv<'a>(kv: *const c_void) -> &'a MemoryKV {
+    &*(kv as *const MemoryKV)
+}
+
+/// Reads the raw value of an app storage var, at the given app state,
+/// out of an in-memory kv-store created via `svm_memory_kv_create`.
+///
+/// The `data_layout` is the app template data layout, encoded as its vars sizes
+/// (big-endian `u32` each). The kv-store is rewound to `state` for the read,
+/// and then back to its previous head, so its state is left as is.
+///
+/// The returned `value` must be destroyed via `svm_byte_array_destroy`.
+#[no_mangle]
+pub unsafe extern "C" fn svm_dep_memory_read_var(
+    value: *mut svm_byte_array,
+    kv: *const c_void,
+    app_addr: svm_byte_array,
+    state: svm_byte_array,
+    data_layout: svm_byte_array,
+    var_id: u32,
+    error: *mut svm_byte_array,
+) -> svm_result_t {
+    let kv = as_memory_kv(kv);
+
+    into_result(
+        read_var(kv, app_addr, state, data_layout, var_id),
+        value,
+        error,
+    )
+}
+
+/// Writes the raw value of an app storage var, on top of the given app state, into an
+/// in-memory kv-store created via `svm_memory_kv_create`, and returns the resulting state.
+/// The `value` length must be the var size. The kv-store head is left as is.
+///
+/// It is meant for setting up tests, and is only used by the go-svm `svmtest` builds.
+/// The returned `new_state` must be destroyed via `svm_byte_array_destroy`.
+#[no_mangle]
+pub unsafe extern "C" fn svm_dep_memory_write_var(
+    new_state: *mut svm_byte_array,
+    kv: *const c_void,
+    app_addr: svm_byte_array,
+    state: svm_byte_array,
+    data_layout: svm_byte_array,
+    var_id: u32,
+    value: svm_byte_array,
+    error: *mut svm_byte_array,
+) -> svm_result_t {
+    let kv = as_memory_kv(kv);
+
+    into_result(
+        write_var(kv, app_addr, state, data_layout, var_id, value),
+        new_state,
+        error,
+    )
+}
+
+/// A kv-store overlay (see `OverlayKV`), typed as the kv-store expected by `svm_memory_runtime_create`.
+struct OverlayHandle {
+    kv: MemoryKV,
+}
+
+/// Creates a kv-store overlay over an in-memory kv-store created via `svm_memory_kv_create`,
+/// for running a query. The kv-store to pass to `svm_memory_runtime_create` is returned by
+/// `svm_dep_overlay_kv_store`. Its writes never reach the underlying kv-store.
+///
+/// The overlay must be destroyed via `svm_dep_overlay_kv_destroy`, after the runtime using it,
+/// which drops its writes, and restores the head of the underlying kv-store.
+#[no_mangle]
+pub unsafe extern "C" fn svm_dep_overlay_kv_create(
+    overlay: *mut *mut c_void,
+    kv: *const c_void,
+) -> svm_result_t {
+    let base = as_memory_kv(kv);
+    let kv: MemoryKV = Rc::new(RefCell::new(OverlayKV::new(base.clone())));
+
+    *overlay = Box::into_raw(Box::new(OverlayHandle { kv })) as *mut c_void;
+
+    svm_result_t::SVM_SUCCESS
+}
+
+/// Returns the kv-store of an overlay, to pass to `svm_memory_runtime_create`.
+#[no_mangle]
+pub unsafe extern "C" fn svm_dep_overlay_kv_store(overlay: *const c_void) -> *const c_void {
+    let handle = &*(overlay as *const OverlayHandle);
+
+    &handle.kv as *const MemoryKV as *const c_void
+}
+
+/// Destroys an overlay created via `svm_dep_overlay_kv_create`.
+#[no_mangle]
+pub unsafe extern "C" fn svm_dep_overlay_kv_destroy(overlay: *mut c_void) {
+    let _ = Box::from_raw(overlay as *mut OverlayHandle);
+}
+
+/// Returns the current head of an in-memory kv-store created via `svm_memory_kv_create`.
+///
+/// It is meant for tests, and is only used by the go-svm `svmtest` builds.
+/// The returned `head` must be destroyed via `svm_byte_array_destroy`.
+#[no_mangle]
+pub unsafe extern "C" fn svm_dep_memory_kv_head(head: *mut svm_byte_array, kv: *const c_void) {
+    let kv = as_memory_kv(kv);
+
+    *head = svm_byte_array::from(kv.borrow().head().as_slice().to_vec());
+}
+
+/// Traps the runtime from within an import function, which aborts the running transaction
+/// like a trapping wasm instruction does. The `ctx` is the one the import function was called with.
+///
+/// It's how wasmer fails a host function which returns an `Err`: it never returns, but unwinds
+/// to the runtime, through the frames of the import function and the wasm code. So it must only
+/// be called by the C trampolines of the go-svm import functions, once their Go call has returned.
+#[no_mangle]
+pub unsafe extern "C" fn svm_dep_import_trap(ctx: *mut c_void) -> ! {
+    let ctx = &*(ctx as *const Ctx);
+
+    (*ctx.module)
+        .runnable_module
+        .do_early_trap(Box::new("import function panicked".to_string()))
+}
+
+unsafe fn read_var(
+    kv: &MemoryKV,
+    app_addr: svm_byte_array,
+    state: svm_byte_array,
+    data_layout: svm_byte_array,
+    var_id: u32,
+) -> Result<Vec<u8>, String> {
+    let app_addr = Address::from(as_slice(app_addr));
+    let state = State::from(as_slice(state));
+    let layout = decode_data_layout(as_slice(data_layout))?;
+
+    if var_id as usize >= layout.len() {
+        return Err(format!("var #{} is out of the data layout", var_id));
+    }
+
+    let head = kv.borrow().head();
+    kv.borrow_mut().rewind(&state);
+
+    let storage = AppStorage::new(&app_addr, &layout, kv);
+    let value = storage.read_var(VarId(var_id));
+
+    kv.borrow_mut().rewind(&head);
+
+    Ok(value)
+}
+
+unsafe fn write_var(
+    kv: &MemoryKV,
+    app_addr: svm_byte_array,
+    state: svm_byte_array,
+    data_layout: svm_byte_array,
+    var_id: u32,
+    value: svm_byte_array,
+) -> Result<Vec<u8>, String> {
+    let app_addr = Address::from(as_slice(app_addr));
+    let state = State::from(as_slice(state));
+    let layout = decode_data_layout(as_slice(data_layout))?;
+
+    if var_id as usize >= layout.len() {
+        return Err(format!("var #{} is out of the data layout", var_id));
+    }
+
+    let (_, size) = layout.get_var(VarId(var_id));
+    if size != value.length {
+        return Err(format!(
+            "var #{} size is {}, but the value has {} bytes",
+            var_id, size, value.length
+        ));
+    }
+
+    let head = kv.borrow().head();
+    kv.borrow_mut().rewind(&state);
+
+    let mut storage = AppStorage::new(&app_addr, &layout, kv);
+    storage.write_var(VarId(var_id), as_slice(value).to_vec());
+    let new_state = storage.commit();
+
+    kv.borrow_mut().rewind(&head);
+
+    Ok(new_state.as_slice().to_vec())
+}
+
+fn decode_data_layout(bytes: &[u8]) -> Result<DataLayout, String> {
+    if bytes.len() % 4 != 0 {
+        return Err(format!("invalid data layout length {}", bytes.len()));
+    }
+
+    let sizes: Vec<u32> = bytes
+        .chunks(4)
+        .map(|c| u32::from_be_bytes([c[0], c[1], c[2], c[3]]))
+        .collect();
+
+    Ok(DataLayout::from(&sizes[..]))
+}
+
+unsafe fn as_slice<'a>(array: svm_byte_array) -> &'a [u8] {
+    if array.length == 0 {
+        return &[];
+    }
+
+    std::slice::from_raw_parts(array.bytes, array.length as usize)
+}
+
+unsafe fn into_error(msg: String, error: *mut svm_byte_array) -> svm_result_t {
+    *error = svm_byte_array::from(msg);
+    svm_result_t::SVM_FAILURE
+}
+
+unsafe fn into_result(
+    result: Result<Vec<u8>, String>,
+    value: *mut svm_byte_array,
+    error: *mut svm_byte_array,
+) -> svm_result_t {
+    match result {
+        Ok(bytes) => {
+            *value = svm_byte_array::from(bytes);
+            svm_result_t::SVM_SUCCESS
+        }
+        Err(msg) => into_error(msg, error),
+    }
+}
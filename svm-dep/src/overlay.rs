@@ -0,0 +1,70 @@
+use std::cell::RefCell;
+use std::collections::HashMap;
+use std::rc::Rc;
+
+use svm_common::State;
+use svm_storage2::kv::StatefulKV;
+
+/// A kv-store layered over the kv-store of a runtime, for running a query.
+///
+/// The writes and the head moves are kept in memory, and dropped along with
+/// the overlay, so the underlying kv-store is never changed by the query.
+pub struct OverlayKV {
+    base: Rc<RefCell<dyn StatefulKV>>,
+    base_head: State,
+    head: State,
+    changes: HashMap<Vec<u8>, Vec<u8>>,
+}
+
+impl OverlayKV {
+    /// Creates an overlay at the current head of the underlying kv-store.
+    pub fn new(base: Rc<RefCell<dyn StatefulKV>>) -> Self {
+        let head = base.borrow().head();
+
+        Self {
+            base,
+            base_head: head.clone(),
+            head,
+            changes: HashMap::new(),
+        }
+    }
+}
+
+impl Drop for OverlayKV {
+    /// Restores the head of the underlying kv-store.
+    fn drop(&mut self) {
+        self.base.borrow_mut().rewind(&self.base_head);
+    }
+}
+
+impl StatefulKV for OverlayKV {
+    fn get(&self, key: &[u8]) -> Option<Vec<u8>> {
+        if let Some(value) = self.changes.get(key) {
+            return Some(value.clone());
+        }
+
+        self.base.borrow().get(key)
+    }
+
+    fn set(&mut self, changes: &[(&[u8], &[u8])]) {
+        for (k, v) in changes {
+            self.changes.insert(k.to_vec(), v.to_vec());
+        }
+    }
+
+    fn head(&self) -> State {
+        self.head.clone()
+    }
+
+    fn rewind(&mut self, state: &State) {
+        // The reads still go through the underlying kv-store,
+        // so it's rewound as well, and then restored on drop.
+        self.head = state.clone();
+        self.base.borrow_mut().rewind(state);
+    }
+
+    fn commit(&mut self) -> State {
+        // The new state of the query is discarded, so nothing is committed.
+        self.head.clone()
+    }
+}
@@ -0,0 +1,261 @@
+package svm
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-svm/svm/wasm"
+	"math"
+	"strings"
+)
+
+// ABIFunc describes a function of a template, which apps can call by name.
+type ABIFunc struct {
+	Name string
+
+	// The function index, as given to EncodeAppTx and EncodeSpawnApp.
+	Index uint16
+
+	Params  ValueTypes
+	Returns ValueTypes
+}
+
+// String helps ABIFunc to implement the Stringer interface.
+func (f ABIFunc) String() string {
+	return fmt.Sprintf("#%v %v(%v) -> (%v)", f.Index, f.Name, formatValueTypes(f.Params), formatValueTypes(f.Returns))
+}
+
+// CheckArgs checks that args match the function params.
+func (f ABIFunc) CheckArgs(args Values) error {
+	if len(args) != len(f.Params) {
+		return newError(OpEncode, ErrValidation, fmt.Sprintf("function `%v` expects %v args, given %v",
+			f.Name, len(f.Params), len(args)))
+	}
+
+	for i, arg := range args {
+		if arg.Type() != f.Params[i] {
+			return newError(OpEncode, ErrValidation, fmt.Sprintf("arg #%v of function `%v` must be %v, given %v",
+				i, f.Name, valueTypeName(f.Params[i]), valueTypeName(arg.Type())))
+		}
+	}
+
+	return nil
+}
+
+// abiFuncJSON is the JSON representation of ABIFunc, with the value types given by name.
+type abiFuncJSON struct {
+	Name    string   `json:"name"`
+	Index   uint16   `json:"index"`
+	Params  []string `json:"params"`
+	Returns []string `json:"returns"`
+}
+
+// MarshalJSON helps ABIFunc to implement the json.Marshaler interface. For example:
+//
+//	{"name": "storage_inc", "index": 0, "params": ["i32"], "returns": []}
+func (f ABIFunc) MarshalJSON() ([]byte, error) {
+	return json.Marshal(abiFuncJSON{
+		Name:    f.Name,
+		Index:   f.Index,
+		Params:  valueTypesNames(f.Params),
+		Returns: valueTypesNames(f.Returns),
+	})
+}
+
+// UnmarshalJSON helps ABIFunc to implement the json.Unmarshaler interface.
+func (f *ABIFunc) UnmarshalJSON(data []byte) error {
+	var v abiFuncJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	params, err := parseValueTypes(v.Params)
+	if err != nil {
+		return fmt.Errorf("invalid params of function `%v`: %w", v.Name, err)
+	}
+	returns, err := parseValueTypes(v.Returns)
+	if err != nil {
+		return fmt.Errorf("invalid returns of function `%v`: %w", v.Name, err)
+	}
+
+	*f = ABIFunc{Name: v.Name, Index: v.Index, Params: params, Returns: returns}
+	return nil
+}
+
+// ABI describes the functions of a template. It is either derived from the template
+// wasm code via ABIFromWasm, or loaded from its JSON representation via ParseABI.
+type ABI struct {
+	Funcs []ABIFunc `json:"funcs"`
+}
+
+// ABIFromWasm derives the ABI of a template out of its wasm code. Its functions are the
+// exported ones, and their index is their position in the module exports.
+func ABIFromWasm(code []byte) (*ABI, error) {
+	module, err := wasm.Parse(code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive ABI: %w", err)
+	}
+
+	abi := &ABI{}
+	for _, e := range module.Exports {
+		if e.Kind != wasm.KindFunc {
+			continue
+		}
+
+		if len(abi.Funcs) > math.MaxUint16 {
+			return nil, fmt.Errorf("failed to derive ABI: too many exported functions; max: %v", math.MaxUint16+1)
+		}
+
+		sig, ok := module.FuncType(e.Index)
+		if !ok {
+			return nil, fmt.Errorf("failed to derive ABI: function `%v` index %v is out of range", e.Name, e.Index)
+		}
+
+		params, err := valueTypesFromWasm(sig.Params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive ABI: invalid params of function `%v`: %w", e.Name, err)
+		}
+		returns, err := valueTypesFromWasm(sig.Results)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive ABI: invalid returns of function `%v`: %w", e.Name, err)
+		}
+
+		abi.Funcs = append(abi.Funcs, ABIFunc{
+			Name:    e.Name,
+			Index:   uint16(len(abi.Funcs)),
+			Params:  params,
+			Returns: returns,
+		})
+	}
+
+	return abi, nil
+}
+
+// ParseABI loads an ABI out of its JSON representation. For example:
+//
+//	{"funcs": [{"name": "storage_inc", "index": 0, "params": ["i32"], "returns": []}]}
+//
+// The functions names and indices must be unique.
+func ParseABI(data []byte) (*ABI, error) {
+	abi := &ABI{}
+	if err := json.Unmarshal(data, abi); err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	names := make(map[string]bool)
+	indices := make(map[uint16]bool)
+	for _, f := range abi.Funcs {
+		if names[f.Name] {
+			return nil, fmt.Errorf("failed to parse ABI: duplicate function name `%v`", f.Name)
+		}
+		if indices[f.Index] {
+			return nil, fmt.Errorf("failed to parse ABI: duplicate function index %v", f.Index)
+		}
+		names[f.Name], indices[f.Index] = true, true
+	}
+
+	return abi, nil
+}
+
+// Func returns the function of the given name.
+func (abi *ABI) Func(name string) (ABIFunc, bool) {
+	for _, f := range abi.Funcs {
+		if f.Name == name {
+			return f, true
+		}
+	}
+
+	return ABIFunc{}, false
+}
+
+// resolve returns the function of the given name, after checking args match its params.
+func (abi *ABI) resolve(name string, args Values) (ABIFunc, error) {
+	if abi == nil {
+		return ABIFunc{}, newError(OpEncode, ErrFuncNotFound, "the template has no ABI")
+	}
+
+	f, ok := abi.Func(name)
+	if !ok {
+		return ABIFunc{}, newError(OpEncode, ErrFuncNotFound, fmt.Sprintf("function `%v` is not in the template ABI", name))
+	}
+
+	if err := f.CheckArgs(args); err != nil {
+		return ABIFunc{}, err
+	}
+
+	return f, nil
+}
+
+// EncodeSpawnApp is like the EncodeSpawnApp function, but the constructor
+// is given by name, and its args are checked against its params.
+func (abi *ABI) EncodeSpawnApp(version int, templateAddr Address, ctorName string, ctorBuffer []byte, ctorArgs Values) ([]byte, error) {
+	f, err := abi.resolve(ctorName, ctorArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeSpawnApp(version, templateAddr, f.Index, ctorBuffer, ctorArgs)
+}
+
+// EncodeAppTx is like the EncodeAppTx function, but the function
+// is given by name, and its args are checked against its params.
+func (abi *ABI) EncodeAppTx(version int, appAddr Address, funcName string, funcBuffer []byte, funcArgs Values) ([]byte, error) {
+	f, err := abi.resolve(funcName, funcArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeAppTx(version, appAddr, f.Index, funcBuffer, funcArgs)
+}
+
+func valueTypeName(t ValueType) string {
+	switch t {
+	case TypeI32:
+		return "i32"
+	case TypeI64:
+		return "i64"
+	default:
+		return fmt.Sprintf("ValueType(%v)", uint8(t))
+	}
+}
+
+func valueTypesNames(types ValueTypes) []string {
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = valueTypeName(t)
+	}
+	return names
+}
+
+func formatValueTypes(types ValueTypes) string {
+	return strings.Join(valueTypesNames(types), ", ")
+}
+
+func parseValueTypes(names []string) (ValueTypes, error) {
+	types := make(ValueTypes, len(names))
+	for i, name := range names {
+		switch name {
+		case "i32":
+			types[i] = TypeI32
+		case "i64":
+			types[i] = TypeI64
+		default:
+			return nil, fmt.Errorf("unsupported value type `%v`; only accept `i32` and `i64`", name)
+		}
+	}
+	return types, nil
+}
+
+func valueTypesFromWasm(wasmTypes []wasm.ValueType) (ValueTypes, error) {
+	types := make(ValueTypes, len(wasmTypes))
+	for i, t := range wasmTypes {
+		switch t {
+		case wasm.I32:
+			types[i] = TypeI32
+		case wasm.I64:
+			types[i] = TypeI64
+		default:
+			return nil, fmt.Errorf("unsupported value type `%v`; only accept `i32` and `i64`", t)
+		}
+	}
+	return types, nil
+}
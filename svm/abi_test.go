@@ -0,0 +1,105 @@
+package svm
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"go-svm/svm/wat"
+	"io/ioutil"
+	"testing"
+)
+
+func TestABIFromWasm(t *testing.T) {
+	req := require.New(t)
+
+	code, err := ioutil.ReadFile("testdata/counter_template.wasm")
+	req.NoError(err)
+
+	abi, err := ABIFromWasm(code)
+	req.NoError(err)
+	req.Equal([]ABIFunc{
+		{Name: "storage_inc", Index: 0, Params: ValueTypes{TypeI32}, Returns: ValueTypes{}},
+		{Name: "storage_get", Index: 1, Params: ValueTypes{}, Returns: ValueTypes{TypeI32}},
+		{Name: "host_inc", Index: 2, Params: ValueTypes{TypeI32}, Returns: ValueTypes{}},
+		{Name: "host_get", Index: 3, Params: ValueTypes{}, Returns: ValueTypes{TypeI32}},
+	}, abi.Funcs)
+
+	f, ok := abi.Func("host_inc")
+	req.True(ok)
+	req.Equal("#2 host_inc(i32) -> ()", f.String())
+
+	_, ok = abi.Func("missing")
+	req.False(ok)
+}
+
+func TestABIFromWasm_Errors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := ABIFromWasm([]byte("wasm"))
+	req.EqualError(err, "failed to derive ABI: malformed wasm module at offset 0: invalid magic number")
+
+	code, err := wat.Compile([]byte(`(module (func (export "f") (param f32)))`))
+	req.NoError(err)
+	_, err = ABIFromWasm(code)
+	req.EqualError(err, "failed to derive ABI: invalid params of function `f`: "+
+		"unsupported value type `f32`; only accept `i32` and `i64`")
+}
+
+func TestABI_JSON(t *testing.T) {
+	req := require.New(t)
+
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	req.NoError(err)
+	abi, err := ABIFromWasm(code)
+	req.NoError(err)
+
+	data, err := json.Marshal(abi)
+	req.NoError(err)
+	req.JSONEq(`{"funcs": [
+		{"name": "storage_inc", "index": 0, "params": ["i32"], "returns": []},
+		{"name": "storage_get", "index": 1, "params": [], "returns": ["i32"]}
+	]}`, string(data))
+
+	parsed, err := ParseABI(data)
+	req.NoError(err)
+	req.Equal(abi, parsed)
+}
+
+func TestParseABI_Errors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := ParseABI([]byte(`{"funcs": [{"name": "f", "params": ["f64"]}]}`))
+	req.EqualError(err, "failed to parse ABI: invalid params of function `f`: "+
+		"unsupported value type `f64`; only accept `i32` and `i64`")
+
+	_, err = ParseABI([]byte(`{"funcs": [{"name": "f"}, {"name": "f", "index": 1}]}`))
+	req.EqualError(err, "failed to parse ABI: duplicate function name `f`")
+
+	_, err = ParseABI([]byte(`{"funcs": [{"name": "f"}, {"name": "g"}]}`))
+	req.EqualError(err, "failed to parse ABI: duplicate function index 0")
+}
+
+func TestABI_Resolve(t *testing.T) {
+	req := require.New(t)
+
+	abi := &ABI{Funcs: []ABIFunc{{Name: "inc", Index: 3, Params: ValueTypes{TypeI32, TypeI64}}}}
+
+	f, err := abi.resolve("inc", Values{I32(1), I64(2)})
+	req.NoError(err)
+	req.Equal(uint16(3), f.Index)
+
+	_, err = abi.resolve("inc", Values{I32(1)})
+	req.True(errors.Is(err, ErrValidation))
+	req.EqualError(err, "svm error: encode: validation failed: function `inc` expects 2 args, given 1")
+
+	_, err = abi.resolve("inc", Values{I32(1), I32(2)})
+	req.EqualError(err, "svm error: encode: validation failed: arg #1 of function `inc` must be i64, given i32")
+
+	_, err = abi.EncodeAppTx(0, Address{}, "dec", nil, nil)
+	req.True(errors.Is(err, ErrFuncNotFound))
+	req.EqualError(err, "svm error: encode: function not found: function `dec` is not in the template ABI")
+
+	var noABI *ABI
+	_, err = noABI.EncodeSpawnApp(0, Address{}, "inc", nil, nil)
+	req.True(errors.Is(err, ErrFuncNotFound))
+}
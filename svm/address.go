@@ -1,8 +1,10 @@
 package svm
 
-const AddressLen = 20
+import "go-svm/svm/codec"
 
-type Address [AddressLen]byte
+const AddressLen = codec.AddressLen
+
+type Address = codec.Address
 
 func bytesToAddress(b []byte) Address {
 	var addr Address
@@ -1,6 +1,8 @@
 package svm
 
-// #cgo LDFLAGS: -Wl,-rpath,${SRCDIR} -L${SRCDIR} -lsvm_runtime_c_api
+// The SVM library is linked by `bridge_nodep.go`, or along with the `svm-dep` shim
+// by `bridge_dep.go`, depending on the `svmdep` build tag.
+
 // #include "./svm.h"
 // #include <string.h>
 //
@@ -23,14 +25,13 @@ func cSvmImportsAlloc(imports *unsafe.Pointer, count uint) cSvmResultT {
 }
 
 func cSvmImportFuncBuild(
-	imports Imports,
+	imports unsafe.Pointer,
 	moduleName string,
 	importName string,
 	cgoFuncPointer unsafe.Pointer,
 	params ValueTypes,
 	returns ValueTypes,
 ) error {
-	cImports := imports.p
 	cModuleName := bytesCloneToSvmByteArray([]byte(moduleName))
 	cImportName := bytesCloneToSvmByteArray([]byte(importName))
 	cParams := bytesCloneToSvmByteArray(params.Encode())
@@ -46,7 +47,7 @@ func cSvmImportFuncBuild(
 	}()
 
 	if res := C.svm_import_func_build(
-		cImports,
+		imports,
 		cModuleName,
 		cImportName,
 		cgoFuncPointer,
@@ -54,7 +55,7 @@ func cSvmImportFuncBuild(
 		cReturns,
 		&cErr,
 	); res != cSvmSuccess {
-		return cErr.svmError()
+		return cErr.svmError(OpBuildImports, ErrRuntime)
 	}
 
 	return nil
@@ -71,7 +72,29 @@ func cSvmMemoryRuntimeCreate(runtime *unsafe.Pointer, kv, host, imports unsafe.P
 		imports,
 		&err,
 	); res != cSvmSuccess {
-		return err.svmError()
+		return err.svmError(OpCreateRuntime, ErrRuntime)
+	}
+
+	return nil
+}
+
+func cSvmRuntimeCreate(runtime *unsafe.Pointer, kvPath string, host, imports unsafe.Pointer) error {
+	cKVPath := bytesCloneToSvmByteArray([]byte(kvPath))
+	err := cSvmByteArray{}
+
+	defer func() {
+		cKVPath.Free()
+		err.SvmFree()
+	}()
+
+	if res := C.svm_runtime_create(
+		runtime,
+		cKVPath,
+		host,
+		imports,
+		&err,
+	); res != cSvmSuccess {
+		return err.svmError(OpCreateRuntime, ErrRuntime)
 	}
 
 	return nil
@@ -105,14 +128,13 @@ func cSvmEncodeAppTemplate(version int, name string, code []byte, dataLayout Dat
 		cDataLayout,
 		&err,
 	); res != cSvmSuccess {
-		return nil, err.svmError()
+		return nil, err.svmError(OpEncode, ErrEncoding)
 	}
 
 	return svmByteArrayCloneToBytes(appTemplate), nil
 }
 
-func cSvmValidateTemplate(runtime Runtime, appTemplate []byte) error {
-	cRuntime := runtime.p
+func cSvmValidateTemplate(runtime unsafe.Pointer, appTemplate []byte) error {
 	cAppTemplate := bytesCloneToSvmByteArray(appTemplate)
 	cErr := cSvmByteArray{}
 
@@ -122,19 +144,18 @@ func cSvmValidateTemplate(runtime Runtime, appTemplate []byte) error {
 	}()
 
 	if res := C.svm_validate_template(
-		cRuntime,
+		runtime,
 		cAppTemplate,
 		&cErr,
 	); res != cSvmSuccess {
-		return cErr.svmError()
+		return cErr.svmError(OpValidate, ErrValidation)
 	}
 
 	return nil
 }
 
-func cSvmDeployTemplate(runtime Runtime, appTemplate []byte, author Address, hostCtx []byte, gasMetering bool, gasLimit uint64) ([]byte, error) {
+func cSvmDeployTemplate(runtime unsafe.Pointer, appTemplate []byte, author Address, hostCtx []byte, gasMetering bool, gasLimit uint64) ([]byte, error) {
 	cReceipt := cSvmByteArray{}
-	cRuntime := runtime.p
 	cAppTemplate := bytesCloneToSvmByteArray(appTemplate)
 	cAuthor := bytesCloneToSvmByteArray(author[:])
 	cHostCtx := bytesCloneToSvmByteArray(hostCtx)
@@ -152,7 +173,7 @@ func cSvmDeployTemplate(runtime Runtime, appTemplate []byte, author Address, hos
 
 	if res := C.svm_deploy_template(
 		&cReceipt,
-		cRuntime,
+		runtime,
 		cAppTemplate,
 		cAuthor,
 		cHostCtx,
@@ -160,12 +181,34 @@ func cSvmDeployTemplate(runtime Runtime, appTemplate []byte, author Address, hos
 		cGasLimit,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(OpDeploy, ErrRuntime)
 	}
 
 	return svmByteArrayCloneToBytes(cReceipt), nil
 }
 
+func cSvmEstimateDeployTemplate(runtime unsafe.Pointer, appTemplate []byte) (uint64, error) {
+	var cEstimation C.uint64_t
+	cAppTemplate := bytesCloneToSvmByteArray(appTemplate)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cAppTemplate.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_estimate_deploy_template(
+		&cEstimation,
+		runtime,
+		cAppTemplate,
+		&cErr,
+	); res != cSvmSuccess {
+		return 0, cErr.svmError(OpEstimate, ErrRuntime)
+	}
+
+	return uint64(cEstimation), nil
+}
+
 func cSvmTemplateReceiptAddr(receipt []byte) (Address, error) {
 	cTemplateAddr := cSvmByteArray{}
 	cReceipt := bytesCloneToSvmByteArray(receipt)
@@ -182,7 +225,7 @@ func cSvmTemplateReceiptAddr(receipt []byte) (Address, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return Address{}, cErr.svmError()
+		return Address{}, cErr.svmError(OpDeploy, ErrInvalidReceipt)
 	}
 
 	return svmByteArrayCloneToAddress(cTemplateAddr), nil
@@ -203,16 +246,15 @@ func cSvmTemplateReceiptGas(receipt []byte) (uint64, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return 0, cErr.svmError()
+		return 0, cErr.svmError(OpDeploy, ErrInvalidReceipt)
 	}
 
 	return uint64(cGasUsed), nil
 }
 
-func cSvmSpawnApp(runtime Runtime, spawnApp []byte, creator Address, hostCtx []byte,
+func cSvmSpawnApp(runtime unsafe.Pointer, spawnApp []byte, creator Address, hostCtx []byte,
 	gasMetering bool, gasLimit uint64) ([]byte, error) {
 	cReceipt := cSvmByteArray{}
-	cRuntime := runtime.p
 	cSpawnApp := bytesCloneToSvmByteArray(spawnApp)
 	cCreator := bytesCloneToSvmByteArray(creator[:])
 	cHostCtx := bytesCloneToSvmByteArray(hostCtx)
@@ -230,7 +272,7 @@ func cSvmSpawnApp(runtime Runtime, spawnApp []byte, creator Address, hostCtx []b
 
 	if res := C.svm_spawn_app(
 		&cReceipt,
-		cRuntime,
+		runtime,
 		cSpawnApp,
 		cCreator,
 		cHostCtx,
@@ -238,12 +280,53 @@ func cSvmSpawnApp(runtime Runtime, spawnApp []byte, creator Address, hostCtx []b
 		cGasLimit,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(OpSpawn, ErrRuntime)
 	}
 
 	return svmByteArrayCloneToBytes(cReceipt), nil
 }
 
+func cSvmEstimateSpawnApp(runtime unsafe.Pointer, spawnApp []byte) (uint64, error) {
+	var cEstimation C.uint64_t
+	cSpawnApp := bytesCloneToSvmByteArray(spawnApp)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cSpawnApp.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_estimate_spawn_app(
+		&cEstimation,
+		runtime,
+		cSpawnApp,
+		&cErr,
+	); res != cSvmSuccess {
+		return 0, cErr.svmError(OpEstimate, ErrRuntime)
+	}
+
+	return uint64(cEstimation), nil
+}
+
+func cSvmAppReceiptStatus(receipt []byte) error {
+	cReceipt := bytesCloneToSvmByteArray(receipt)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cReceipt.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_app_receipt_status(
+		cReceipt,
+		&cErr,
+	); res != cSvmSuccess {
+		return cErr.svmError(OpSpawn, ErrRuntime)
+	}
+
+	return nil
+}
+
 func cSvmAppReceiptState(receipt []byte) ([]byte, error) {
 	cInitialState := cSvmByteArray{}
 	cReceipt := bytesCloneToSvmByteArray(receipt)
@@ -260,7 +343,7 @@ func cSvmAppReceiptState(receipt []byte) ([]byte, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(OpSpawn, ErrInvalidReceipt)
 	}
 
 	return svmByteArrayCloneToBytes(cInitialState), nil
@@ -282,7 +365,7 @@ func cSvmAppReceiptAddr(receipt []byte) (Address, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return Address{}, cErr.svmError()
+		return Address{}, cErr.svmError(OpSpawn, ErrInvalidReceipt)
 	}
 
 	return svmByteArrayCloneToAddress(cAppAddr), nil
@@ -303,12 +386,39 @@ func cSvmAppReceiptGas(receipt []byte) (uint64, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return 0, cErr.svmError()
+		return 0, cErr.svmError(OpSpawn, ErrInvalidReceipt)
 	}
 
 	return uint64(cGasUsed), nil
 }
 
+func cSvmAppReceiptReturns(receipt []byte) (Values, error) {
+	cReturns := cSvmByteArray{}
+	cReceipt := bytesCloneToSvmByteArray(receipt)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cReturns.SvmFree()
+		cReceipt.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_app_receipt_returns(
+		&cReturns,
+		cReceipt,
+		&cErr,
+	); res != cSvmSuccess {
+		return nil, cErr.svmError(OpSpawn, ErrInvalidReceipt)
+	}
+
+	var nativeReturns Values
+	if err := (&nativeReturns).Decode(svmByteArrayCloneToBytes(cReturns)); err != nil {
+		return nil, newError(OpSpawn, ErrInvalidReceipt, fmt.Sprintf("failed to decode returns: %v", err))
+	}
+
+	return nativeReturns, nil
+}
+
 func cSvmEncodeSpawnApp(version int, templateAddr Address, ctorIndex uint16, ctorBuffer []byte, ctorArgs Values) ([]byte, error) {
 	spawnApp := cSvmByteArray{}
 	cVersion := C.uint(version)
@@ -334,14 +444,13 @@ func cSvmEncodeSpawnApp(version int, templateAddr Address, ctorIndex uint16, cto
 		cCtorArgs,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(OpEncode, ErrEncoding)
 	}
 
 	return svmByteArrayCloneToBytes(spawnApp), nil
 }
 
-func cSvmValidateApp(runtime Runtime, app []byte) error {
-	cRuntime := runtime.p
+func cSvmValidateApp(runtime unsafe.Pointer, app []byte) error {
 	cApp := bytesCloneToSvmByteArray(app)
 	cErr := cSvmByteArray{}
 
@@ -351,11 +460,11 @@ func cSvmValidateApp(runtime Runtime, app []byte) error {
 	}()
 
 	if res := C.svm_validate_app(
-		cRuntime,
+		runtime,
 		cApp,
 		&cErr,
 	); res != cSvmSuccess {
-		return cErr.svmError()
+		return cErr.svmError(OpValidate, ErrValidation)
 	}
 
 	return nil
@@ -393,15 +502,14 @@ func cSvmEncodeAppTx(
 		cFuncArgs,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(OpEncode, ErrEncoding)
 	}
 
 	return svmByteArrayCloneToBytes(appTx), nil
 }
 
-func cSvmValidateTx(runtime Runtime, appTx []byte) (Address, error) {
+func cSvmValidateTx(runtime unsafe.Pointer, appTx []byte) (Address, error) {
 	cAppAddr := cSvmByteArray{}
-	cRuntime := runtime.p
 	cAppTx := bytesCloneToSvmByteArray(appTx)
 	cErr := cSvmByteArray{}
 
@@ -413,20 +521,20 @@ func cSvmValidateTx(runtime Runtime, appTx []byte) (Address, error) {
 
 	if res := C.svm_validate_tx(
 		&cAppAddr,
-		cRuntime,
+		runtime,
 		cAppTx,
 		&cErr,
 	); res != cSvmSuccess {
-		return Address{}, cErr.svmError()
+		return Address{}, cErr.svmError(OpValidate, ErrValidation)
 	}
 
 	return svmByteArrayCloneToAddress(cAppAddr), nil
 }
 
-func cSvmExecApp(runtime Runtime, appTx []byte, appState []byte, hostCtx []byte, gasMetering bool,
+// cSvmExecApp executes the raw app-tx, for the given op, which is either OpExec or OpQuery.
+func cSvmExecApp(op Op, runtime unsafe.Pointer, appTx []byte, appState []byte, hostCtx []byte, gasMetering bool,
 	gasLimit uint64) ([]byte, error) {
 	cReceipt := cSvmByteArray{}
-	cRuntime := runtime.p
 	cAppTx := bytesCloneToSvmByteArray(appTx)
 	cAppState := bytesCloneToSvmByteArray(appState)
 	cHostCtx := bytesCloneToSvmByteArray(hostCtx)
@@ -444,7 +552,7 @@ func cSvmExecApp(runtime Runtime, appTx []byte, appState []byte, hostCtx []byte,
 
 	if res := C.svm_exec_app(
 		&cReceipt,
-		cRuntime,
+		runtime,
 		cAppTx,
 		cAppState,
 		cHostCtx,
@@ -452,13 +560,54 @@ func cSvmExecApp(runtime Runtime, appTx []byte, appState []byte, hostCtx []byte,
 		cGasLimit,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(op, ErrRuntime)
 	}
 
 	return svmByteArrayCloneToBytes(cReceipt), nil
 }
 
-func cSvmExecReceiptState(receipt []byte) ([]byte, error) {
+func cSvmEstimateExecApp(runtime unsafe.Pointer, appTx []byte) (uint64, error) {
+	var cEstimation C.uint64_t
+	cAppTx := bytesCloneToSvmByteArray(appTx)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cAppTx.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_estimate_exec_app(
+		&cEstimation,
+		runtime,
+		cAppTx,
+		&cErr,
+	); res != cSvmSuccess {
+		return 0, cErr.svmError(OpEstimate, ErrRuntime)
+	}
+
+	return uint64(cEstimation), nil
+}
+
+func cSvmExecReceiptStatus(op Op, receipt []byte) error {
+	cReceipt := bytesCloneToSvmByteArray(receipt)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cReceipt.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_exec_receipt_status(
+		cReceipt,
+		&cErr,
+	); res != cSvmSuccess {
+		return cErr.svmError(op, ErrRuntime)
+	}
+
+	return nil
+}
+
+func cSvmExecReceiptState(op Op, receipt []byte) ([]byte, error) {
 	cNewState := cSvmByteArray{}
 	cReceipt := bytesCloneToSvmByteArray(receipt)
 	cErr := cSvmByteArray{}
@@ -474,13 +623,13 @@ func cSvmExecReceiptState(receipt []byte) ([]byte, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(op, ErrInvalidReceipt)
 	}
 
 	return svmByteArrayCloneToBytes(cNewState), nil
 }
 
-func cSvmExecReceiptReturns(receipt []byte) (Values, error) {
+func cSvmExecReceiptReturns(op Op, receipt []byte) (Values, error) {
 	cReturns := cSvmByteArray{}
 	cReceipt := bytesCloneToSvmByteArray(receipt)
 	cErr := cSvmByteArray{}
@@ -496,18 +645,18 @@ func cSvmExecReceiptReturns(receipt []byte) (Values, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return nil, cErr.svmError()
+		return nil, cErr.svmError(op, ErrInvalidReceipt)
 	}
 
 	var nativeReturns Values
 	if err := (&nativeReturns).Decode(svmByteArrayCloneToBytes(cReturns)); err != nil {
-		return nil, fmt.Errorf("failed to decode returns: %v", err)
+		return nil, newError(op, ErrInvalidReceipt, fmt.Sprintf("failed to decode returns: %v", err))
 	}
 
 	return nativeReturns, nil
 }
 
-func cSvmExecReceiptGas(receipt []byte) (uint64, error) {
+func cSvmExecReceiptGas(op Op, receipt []byte) (uint64, error) {
 	var cGasUsed C.uint64_t
 	cReceipt := bytesCloneToSvmByteArray(receipt)
 	cErr := cSvmByteArray{}
@@ -522,7 +671,7 @@ func cSvmExecReceiptGas(receipt []byte) (uint64, error) {
 		cReceipt,
 		&cErr,
 	); res != cSvmSuccess {
-		return 0, cErr.svmError()
+		return 0, cErr.svmError(op, ErrInvalidReceipt)
 	}
 
 	return uint64(cGasUsed), nil
@@ -536,18 +685,22 @@ func cSvmByteArrayDestroy(ba cSvmByteArray) {
 	C.svm_byte_array_destroy(ba)
 }
 
-func cSvmRuntimeDestroy(runtime Runtime) {
-	C.svm_runtime_destroy(runtime.p)
+func cSvmRuntimeDestroy(runtime unsafe.Pointer) {
+	C.svm_runtime_destroy(runtime)
 }
 
-func cSvmImportsDestroy(imports Imports) {
-	C.svm_imports_destroy(imports.p)
+func cSvmImportsDestroy(imports unsafe.Pointer) {
+	C.svm_imports_destroy(imports)
 }
 
-func cSvmMemKVDestroy(kv MemKVStore) {
-	C.svm_memory_kv_destroy(kv.p)
+func cSvmMemKVDestroy(kv unsafe.Pointer) {
+	C.svm_memory_kv_destroy(kv)
 }
 
 func cFree(p unsafe.Pointer) {
 	C.free(p)
 }
+
+func cMalloc(size uint) unsafe.Pointer {
+	return C.malloc(C.size_t(size))
+}
@@ -0,0 +1,98 @@
+//go:build svmdep
+// +build svmdep
+
+package svm
+
+// The functions of the `svm-dep` shim (`libsvm_dep`), which complement the SVM C API.
+// The shim is only linked with the `svmdep` build tag, since it isn't shipped along with
+// the SVM library artifacts: it has to be built via `just build-svm`. Without it,
+// the functions of `bridge_nodep.go` fail the shim-backed calls with ErrUnsupported.
+//
+// The shim casts the kv-stores created by the SVM C API to its Rust types, and traps
+// the runtime via its wasmer ctx, which is only sound if they are the same types, built
+// by the same compiler. So `libsvm_dep` embeds and exports the SVM C API itself, out of
+// the sources pinned by `svm-dep/Cargo.lock`, and it is linked instead of `libsvm_runtime_c_api`.
+
+// #cgo LDFLAGS: -Wl,-rpath,${SRCDIR} -L${SRCDIR} -lsvm_dep
+// #include "./svm_dep.h"
+//
+// extern int64_t svmImportDispatch(void *ctx, int slot, int64_t *args, int nargs, int *trap);
+//
+// // Called by the trampolines (see `trampolines.go`). It traps the runtime once svmImportDispatch
+// // has returned, so the trap never unwinds through Go frames.
+// int64_t svm_import_dispatch(void *ctx, int slot, int64_t *args, int nargs) {
+//   int trap = 0;
+//   int64_t ret = svmImportDispatch(ctx, slot, args, nargs, &trap);
+//   if (trap) {
+//     svm_dep_import_trap(ctx);
+//   }
+//   return ret;
+// }
+//
+import "C"
+
+import "unsafe"
+
+// importTrapSupported reports whether an import function panic can trap the runtime,
+// which requires the `svm-dep` shim (see dispatchImport).
+const importTrapSupported = true
+
+// cSvmDepReadVar reads the raw value of an app storage var via the `svm-dep` shim,
+// out of the in-memory kv-store kv.
+func cSvmDepReadVar(op Op, kv unsafe.Pointer, appAddr Address, state []byte, dataLayout DataLayout,
+	varID uint32) ([]byte, error) {
+	cValue := cSvmByteArray{}
+	cAppAddr := bytesCloneToSvmByteArray(appAddr[:])
+	cState := bytesCloneToSvmByteArray(state)
+	cDataLayout := bytesCloneToSvmByteArray(dataLayout.Encode())
+	cVarID := C.uint32_t(varID)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cValue.SvmFree()
+		cAppAddr.Free()
+		cState.Free()
+		cDataLayout.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_dep_memory_read_var(
+		&cValue,
+		kv,
+		cAppAddr,
+		cState,
+		cDataLayout,
+		cVarID,
+		&cErr,
+	); res != cSvmSuccess {
+		return nil, cErr.svmError(op, ErrRuntime)
+	}
+
+	return svmByteArrayCloneToBytes(cValue), nil
+}
+
+func cSvmDepOverlayKVCreate(overlay *unsafe.Pointer, kv unsafe.Pointer) error {
+	if res := C.svm_dep_overlay_kv_create(overlay, kv); res != cSvmSuccess {
+		return newError(OpQuery, ErrAllocation, "failed to create kv-store overlay")
+	}
+
+	return nil
+}
+
+func cSvmDepOverlayKVStore(overlay unsafe.Pointer) unsafe.Pointer {
+	return unsafe.Pointer(C.svm_dep_overlay_kv_store(overlay))
+}
+
+func cSvmDepOverlayKVDestroy(overlay unsafe.Pointer) {
+	C.svm_dep_overlay_kv_destroy(overlay)
+}
+
+// cSvmDepMemoryKVHead returns the current head of the in-memory kv-store kv, via the `svm-dep` shim.
+func cSvmDepMemoryKVHead(kv unsafe.Pointer) []byte {
+	cHead := cSvmByteArray{}
+	defer cHead.SvmFree()
+
+	C.svm_dep_memory_kv_head(&cHead, kv)
+
+	return svmByteArrayCloneToBytes(cHead)
+}
@@ -0,0 +1,47 @@
+//go:build svmtest && svmdep
+// +build svmtest,svmdep
+
+package svm
+
+// #include "./svm_dep.h"
+//
+import "C"
+
+import "unsafe"
+
+// cSvmDepWriteVar writes the raw value of an app storage var via the `svm-dep` shim,
+// into the in-memory kv-store kv, and returns the resulting app state.
+func cSvmDepWriteVar(kv unsafe.Pointer, appAddr Address, state []byte, dataLayout DataLayout, varID uint32,
+	value []byte) ([]byte, error) {
+	cNewState := cSvmByteArray{}
+	cAppAddr := bytesCloneToSvmByteArray(appAddr[:])
+	cState := bytesCloneToSvmByteArray(state)
+	cDataLayout := bytesCloneToSvmByteArray(dataLayout.Encode())
+	cVarID := C.uint32_t(varID)
+	cValue := bytesCloneToSvmByteArray(value)
+	cErr := cSvmByteArray{}
+
+	defer func() {
+		cNewState.SvmFree()
+		cAppAddr.Free()
+		cState.Free()
+		cDataLayout.Free()
+		cValue.Free()
+		cErr.SvmFree()
+	}()
+
+	if res := C.svm_dep_memory_write_var(
+		&cNewState,
+		kv,
+		cAppAddr,
+		cState,
+		cDataLayout,
+		cVarID,
+		cValue,
+		&cErr,
+	); res != cSvmSuccess {
+		return nil, cErr.svmError(OpWriteStorage, ErrRuntime)
+	}
+
+	return svmByteArrayCloneToBytes(cNewState), nil
+}
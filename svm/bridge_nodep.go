@@ -0,0 +1,50 @@
+//go:build !svmdep
+// +build !svmdep
+
+package svm
+
+// #cgo LDFLAGS: -Wl,-rpath,${SRCDIR} -L${SRCDIR} -lsvm_runtime_c_api
+// #include <stdint.h>
+//
+// extern int64_t svmImportDispatch(void *ctx, int slot, int64_t *args, int nargs, int *trap);
+//
+// // Called by the trampolines (see `trampolines.go`). The runtime can't be trapped
+// // without the shim, so the trap is never set.
+// int64_t svm_import_dispatch(void *ctx, int slot, int64_t *args, int nargs) {
+//   int trap = 0;
+//   return svmImportDispatch(ctx, slot, args, nargs, &trap);
+// }
+//
+import "C"
+
+import "unsafe"
+
+// The stand-ins of the `svm-dep` shim functions (see `bridge_dep.go`), for the builds
+// without the `svmdep` build tag, in which the shim-backed calls fail with ErrUnsupported.
+
+// importTrapSupported reports whether an import function panic can trap the runtime,
+// which requires the `svm-dep` shim (see dispatchImport).
+const importTrapSupported = false
+
+func cSvmDepReadVar(op Op, kv unsafe.Pointer, appAddr Address, state []byte, dataLayout DataLayout,
+	varID uint32) ([]byte, error) {
+	return nil, errDepUnsupported(op)
+}
+
+func cSvmDepOverlayKVCreate(overlay *unsafe.Pointer, kv unsafe.Pointer) error {
+	return errDepUnsupported(OpQuery)
+}
+
+func cSvmDepOverlayKVStore(overlay unsafe.Pointer) unsafe.Pointer {
+	return nil
+}
+
+func cSvmDepOverlayKVDestroy(overlay unsafe.Pointer) {}
+
+func cSvmDepMemoryKVHead(kv unsafe.Pointer) []byte {
+	return nil
+}
+
+func errDepUnsupported(op Op) error {
+	return newError(op, ErrUnsupported, "it requires the svm-dep shim; build with the `svmdep` build tag")
+}
@@ -0,0 +1,33 @@
+//go:build !svmdep
+// +build !svmdep
+
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"unsafe"
+)
+
+func TestDepUnsupported(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+	defer runtime.Free()
+
+	var kv byte
+	runtime.memKV = unsafe.Pointer(&kv)
+
+	vars, err := NewDataLayoutBuilder().U32("counter").Build()
+	req.NoError(err)
+
+	_, err = runtime.ReadVar(Address{}, nil, vars, 0)
+	req.True(errors.Is(err, ErrUnsupported), "unexpected error: %v", err)
+	req.EqualError(err,
+		"svm error: read storage: unsupported: it requires the svm-dep shim; build with the `svmdep` build tag")
+
+	_, err = QueryApp(runtime, nil, nil, nil)
+	req.True(errors.Is(err, ErrUnsupported), "unexpected error: %v", err)
+}
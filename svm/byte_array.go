@@ -34,10 +34,10 @@ func (ba cSvmByteArray) AsCBytes() CBytes {
 	}
 }
 
-// svmError converts an SVM byte array to a Go error.
-func (ba cSvmByteArray) svmError() error {
+// svmError converts an SVM byte array error message to a Go error.
+func (ba cSvmByteArray) svmError(op Op, kind error) error {
 	b := svmByteArrayCloneToBytes(ba)
-	return newSvmError(b)
+	return newError(op, kind, string(b))
 }
 
 // String helps cSvmByteArray to implement the Stringer interface.
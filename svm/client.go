@@ -0,0 +1,257 @@
+package svm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Client is a high-level API over a Runtime. It encodes, validates and runs
+// the transactions, and keeps track of the state of the apps it spawns.
+//
+// A Client is configured like a RuntimeBuilder, with each With* method returning
+// an updated copy, and all the transactions it runs share its configuration.
+type Client struct {
+	runtime Runtime
+	version int
+	sender  Address
+	opts    *ExecOptions
+}
+
+// NewClient creates a new client over the runtime.
+// By default, the transactions are encoded with version 0, are sent by the zero address,
+// and run with the runtime default options.
+func NewClient(runtime Runtime) Client {
+	return Client{runtime: runtime}
+}
+
+// WithVersion sets the version of the encoded transactions.
+func (c Client) WithVersion(version int) Client {
+	c.version = version
+	return c
+}
+
+// WithSender sets the address used as the templates author, and the apps creator.
+func (c Client) WithSender(sender Address) Client {
+	c.sender = sender
+	return c
+}
+
+// WithExecOptions sets the options of the transactions, over the runtime default options (see ExecOptions).
+func (c Client) WithExecOptions(opts ExecOptions) Client {
+	c.opts = &opts
+	return c
+}
+
+// Runtime returns the runtime of the client.
+func (c Client) Runtime() Runtime {
+	return c.runtime
+}
+
+// Template is an app template deployed via Client.Deploy.
+type Template struct {
+	client Client
+
+	// The template address.
+	Addr Address
+
+	// The deploy-template result.
+	DeployResult *DeployTemplateResult
+
+	// The template ABI, used for calling its functions by name.
+	// It is derived from the template code, and may be replaced (e.g. via ParseABI)
+	// before its apps are spawned. It is nil if it can't be derived (e.g. when a function
+	// has `f32` params), and then calling the functions by name fails with the reason.
+	ABI *ABI
+
+	// The failure to derive the ABI, if any.
+	abiErr error
+}
+
+// Deploy deploys an app template out of its wasm code.
+// Its ABI is derived on a best-effort basis (see Template.ABI).
+func (c Client) Deploy(code []byte, dataLayout DataLayout, name string) (*Template, error) {
+	abi, abiErr := ABIFromWasm(code)
+
+	appTemplate, err := EncodeAppTemplate(c.version, name, code, dataLayout)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateTemplate(c.runtime, appTemplate); err != nil {
+		return nil, err
+	}
+
+	res, err := c.runtime.Deploy(context.Background(), appTemplate, c.sender, c.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Template{client: c, Addr: res.TemplateAddr, DeployResult: res, ABI: abi, abiErr: abiErr}, nil
+}
+
+// App is an app spawned via Template.Spawn.
+// It keeps its latest state, which is updated by each successful call.
+// An App may be used from several goroutines, and its calls are serialized.
+type App struct {
+	client Client
+
+	// The app address.
+	Addr Address
+
+	// The address of the app template.
+	TemplateAddr Address
+
+	// The spawn-app result.
+	SpawnResult *SpawnAppResult
+
+	// The template ABI, used for calling the app functions by name.
+	ABI *ABI
+
+	// The failure to derive the template ABI, if any.
+	abiErr error
+
+	mu    sync.Mutex
+	state []byte
+}
+
+// Spawn spawns a new app of the template, calling its constructor with the given args.
+// A failed spawn is returned as an error (see SpawnAppResult.Err).
+func (t *Template) Spawn(ctorIndex uint16, args Values) (*App, error) {
+	c := t.client
+
+	spawnApp, err := EncodeSpawnApp(c.version, t.Addr, ctorIndex, nil, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateApp(c.runtime, spawnApp); err != nil {
+		return nil, err
+	}
+
+	res, err := c.runtime.Spawn(context.Background(), spawnApp, c.sender, c.opts)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return nil, res.Err
+	}
+
+	return &App{
+		client:       c,
+		Addr:         res.AppAddr,
+		TemplateAddr: t.Addr,
+		SpawnResult:  res,
+		ABI:          t.ABI,
+		abiErr:       t.abiErr,
+		state:        res.InitialState,
+	}, nil
+}
+
+// SpawnByName is like Spawn, but the constructor is given by name,
+// and its args are checked against its params, as described by the template ABI.
+func (t *Template) SpawnByName(ctorName string, args ...Value) (*App, error) {
+	f, err := resolveABI(t.ABI, t.abiErr, ctorName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Spawn(f.Index, args)
+}
+
+// State returns the latest app state.
+func (a *App) State() []byte {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.state
+}
+
+// Call calls the app function with the given args, against the latest app state.
+// On success, the app state is updated to the new state.
+// A failed call returns both its result and its error (see ExecAppResult.Err),
+// and leaves the app state as is.
+func (a *App) Call(funcIndex uint16, args Values) (*ExecAppResult, error) {
+	c := a.client
+
+	appTx, err := EncodeAppTx(c.version, a.Addr, funcIndex, nil, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ValidateAppTx(c.runtime, appTx); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	res, err := c.runtime.Exec(context.Background(), appTx, a.state, c.opts)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return res, res.Err
+	}
+
+	a.state = res.NewState
+	return res, nil
+}
+
+// CallByName is like Call, but the function is given by name,
+// and its args are checked against its params, as described by the app ABI.
+func (a *App) CallByName(funcName string, args ...Value) (*ExecAppResult, error) {
+	f, err := resolveABI(a.ABI, a.abiErr, funcName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Call(f.Index, args)
+}
+
+// Query calls the app function with the given args, against the latest app state,
+// without changing it, nor the runtime kv-store (see QueryApp).
+// A failed query returns both its result and its error (see QueryAppResult.Err).
+func (a *App) Query(funcIndex uint16, args Values) (*QueryAppResult, error) {
+	c := a.client
+
+	appTx, err := EncodeAppTx(c.version, a.Addr, funcIndex, nil, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ValidateAppTx(c.runtime, appTx); err != nil {
+		return nil, err
+	}
+
+	res, err := c.runtime.Query(context.Background(), appTx, a.State(), c.opts)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return res, res.Err
+	}
+
+	return res, nil
+}
+
+// QueryByName is like Query, but the function is given by name,
+// and its args are checked against its params, as described by the app ABI.
+func (a *App) QueryByName(funcName string, args ...Value) (*QueryAppResult, error) {
+	f, err := resolveABI(a.ABI, a.abiErr, funcName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.Query(f.Index, args)
+}
+
+// resolveABI returns the function of the given name out of the ABI (see ABI.resolve),
+// or the failure to derive the ABI, when it is nil because of it.
+func resolveABI(abi *ABI, abiErr error, name string, args Values) (ABIFunc, error) {
+	if abi == nil && abiErr != nil {
+		return ABIFunc{}, newError(OpEncode, ErrFuncNotFound, fmt.Sprintf("the template has no ABI: %v", abiErr))
+	}
+
+	return abi.resolve(name, args)
+}
@@ -0,0 +1,104 @@
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"go-svm/svm/wat"
+	"io/ioutil"
+	"testing"
+)
+
+func TestClient_Config(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+	defer runtime.Free()
+
+	sender := bytesToAddress([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20})
+
+	c := NewClient(runtime)
+	req.Equal(runtime, c.Runtime())
+	req.Nil(c.opts)
+
+	configured := c.WithVersion(1).WithSender(sender).WithExecOptions(ExecOptions{Gas: &GasOptions{Metering: true, Limit: 10}})
+	req.Equal(1, configured.version)
+	req.Equal(sender, configured.sender)
+	req.Equal(&ExecOptions{Gas: &GasOptions{Metering: true, Limit: 10}}, configured.opts)
+
+	// The original client is left as is.
+	req.Equal(0, c.version)
+	req.Nil(c.opts)
+}
+
+func TestResolveABI(t *testing.T) {
+	req := require.New(t)
+
+	// An ABI which can't be derived fails the calls by name with the reason.
+	code, err := wat.Compile([]byte(`(module (func (export "f") (param f32)))`))
+	req.NoError(err)
+	abi, abiErr := ABIFromWasm(code)
+	req.Nil(abi)
+	req.Error(abiErr)
+
+	_, err = resolveABI(abi, abiErr, "f", nil)
+	req.True(errors.Is(err, ErrFuncNotFound))
+	req.EqualError(err, "svm error: encode: function not found: the template has no ABI: "+abiErr.Error())
+
+	// A replaced ABI is used instead.
+	abi = &ABI{Funcs: []ABIFunc{{Name: "f", Index: 3}}}
+	f, err := resolveABI(abi, abiErr, "f", nil)
+	req.NoError(err)
+	req.Equal(uint16(3), f.Index)
+}
+
+func TestClient(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	req.NoError(err)
+
+	template, err := NewClient(runtime).Deploy(code, DataLayout{4}, "storage")
+	req.NoError(err)
+
+	app, err := template.Spawn(storageIncFuncIndex, Values{I32(5)})
+	req.NoError(err)
+	req.Equal(template.Addr, app.TemplateAddr)
+	req.Equal(app.SpawnResult.InitialState, app.State())
+
+	// The app keeps its latest state between the calls.
+	_, err = app.Call(storageIncFuncIndex, Values{I32(3)})
+	req.NoError(err)
+	_, err = app.Call(storageIncFuncIndex, Values{I32(3)})
+	req.NoError(err)
+	res, err := app.Call(storageGetFuncIndex, nil)
+	req.NoError(err)
+	req.Equal(Values{I32(11)}, res.Returns)
+	req.Equal(res.NewState, app.State())
+
+	// A failed call leaves the state as is.
+	res, err = app.Call(100, nil)
+	req.True(errors.Is(err, ErrFuncNotFound), "unexpected error: %v", err)
+	req.False(res.Success)
+
+	res, err = app.Call(storageGetFuncIndex, nil)
+	req.NoError(err)
+	req.Equal(Values{I32(11)}, res.Returns)
+
+	// The functions can be called by name, as described by the template ABI.
+	_, err = app.CallByName("storage_inc", I32(4))
+	req.NoError(err)
+	res, err = app.CallByName("storage_get")
+	req.NoError(err)
+	req.Equal(Values{I32(15)}, res.Returns)
+
+	_, err = app.CallByName("storage_inc", I64(4))
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+
+	byName, err := template.SpawnByName("storage_inc", I32(1))
+	req.NoError(err)
+	req.Equal(template.ABI, byName.ABI)
+}
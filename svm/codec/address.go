@@ -0,0 +1,5 @@
+package codec
+
+const AddressLen = 20
+
+type Address [AddressLen]byte
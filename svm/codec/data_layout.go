@@ -0,0 +1,282 @@
+package codec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+type DataLayout []uint32
+
+func (dl DataLayout) Encode() []byte {
+	buf := make([]byte, len(dl)*4)
+	offset := 0
+
+	for _, v := range dl {
+		binary.BigEndian.PutUint32(buf[offset:], v)
+		offset += 4
+	}
+
+	return buf
+}
+
+// DecodeDataLayout decodes a data layout according to the encoding format
+// defined in DataLayout.Encode.
+func DecodeDataLayout(data []byte) (DataLayout, error) {
+	if len(data)%4 != 0 {
+		return nil, fmt.Errorf("failed to decode data layout: "+
+			"length must be a multiple of 4; given: %v", len(data))
+	}
+
+	dl := make(DataLayout, len(data)/4)
+	for i := range dl {
+		dl[i] = binary.BigEndian.Uint32(data[i*4:])
+	}
+
+	return dl, nil
+}
+
+// VarType is the type of a data layout variable.
+type VarType uint8
+
+const (
+	VarU32 VarType = iota
+	VarU64
+	VarBool
+	VarAddress
+	VarBytes
+)
+
+// String helps VarType to implement the Stringer interface.
+func (t VarType) String() string {
+	switch t {
+	case VarU32:
+		return "u32"
+	case VarU64:
+		return "u64"
+	case VarBool:
+		return "bool"
+	case VarAddress:
+		return "address"
+	case VarBytes:
+		return "bytes"
+	default:
+		return fmt.Sprintf("VarType(%v)", uint8(t))
+	}
+}
+
+// Var is a named, typed variable of a data layout.
+type Var struct {
+	// The var ID, which is the var index in the data layout.
+	ID   uint32
+	Name string
+	Type VarType
+	Size uint32
+}
+
+// String helps Var to implement the Stringer interface.
+func (v Var) String() string {
+	if v.Type == VarBytes {
+		return fmt.Sprintf("#%v %v: bytes[%v]", v.ID, v.Name, v.Size)
+	}
+
+	return fmt.Sprintf("#%v %v: %v", v.ID, v.Name, v.Type)
+}
+
+// Decode decodes a raw storage value of the var. Numbers are assumed to be big-endian encoded,
+// since the encoding of the values stored via `svm.set32`/`svm.set64` isn't specified by the SVM C API;
+// it is checked by TestRuntime_ReadVar against the values returned by the template getter.
+// The returned value is an uint32, uint64, bool, Address or []byte, depending on the var type.
+func (v Var) Decode(raw []byte) (interface{}, error) {
+	if uint32(len(raw)) != v.Size {
+		return nil, fmt.Errorf("failed to decode var `%v`: invalid size; expected: %v, given: %v",
+			v.Name, v.Size, len(raw))
+	}
+
+	switch v.Type {
+	case VarU32:
+		return binary.BigEndian.Uint32(raw), nil
+	case VarU64:
+		return binary.BigEndian.Uint64(raw), nil
+	case VarBool:
+		switch raw[0] {
+		case 0:
+			return false, nil
+		case 1:
+			return true, nil
+		default:
+			return nil, fmt.Errorf("failed to decode var `%v`: invalid bool value %v", v.Name, raw[0])
+		}
+	case VarAddress:
+		var addr Address
+		copy(addr[:], raw)
+		return addr, nil
+	case VarBytes:
+		return append([]byte{}, raw...), nil
+	default:
+		return nil, fmt.Errorf("failed to decode var `%v`: unsupported var type %v", v.Name, v.Type)
+	}
+}
+
+// Encode encodes a value of the var as a raw storage value, as decoded by Decode.
+// The value must be an uint32, uint64, bool, Address or []byte, according to the var type.
+func (v Var) Encode(value interface{}) ([]byte, error) {
+	raw := make([]byte, v.Size)
+
+	switch x := value.(type) {
+	case uint32:
+		if v.Type == VarU32 {
+			binary.BigEndian.PutUint32(raw, x)
+			return raw, nil
+		}
+	case uint64:
+		if v.Type == VarU64 {
+			binary.BigEndian.PutUint64(raw, x)
+			return raw, nil
+		}
+	case bool:
+		if v.Type == VarBool {
+			if x {
+				raw[0] = 1
+			}
+			return raw, nil
+		}
+	case Address:
+		if v.Type == VarAddress {
+			copy(raw, x[:])
+			return raw, nil
+		}
+	case []byte:
+		if v.Type == VarBytes {
+			if uint32(len(x)) != v.Size {
+				return nil, fmt.Errorf("failed to encode var `%v`: invalid size; expected: %v, given: %v",
+					v.Name, v.Size, len(x))
+			}
+			copy(raw, x)
+			return raw, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to encode var `%v`: a %v var can't hold a %T value", v.Name, v.Type, value)
+}
+
+// Vars are the variables of a data layout, ordered by their var ID.
+type Vars []Var
+
+// DataLayout returns the vars sizes, as given to EncodeAppTemplate.
+func (vars Vars) DataLayout() DataLayout {
+	dl := make(DataLayout, len(vars))
+	for i, v := range vars {
+		dl[i] = v.Size
+	}
+
+	return dl
+}
+
+// Lookup returns the var of the given name.
+func (vars Vars) Lookup(name string) (Var, bool) {
+	for _, v := range vars {
+		if v.Name == name {
+			return v, true
+		}
+	}
+
+	return Var{}, false
+}
+
+// Decode decodes a raw storage value of the var of the given name.
+func (vars Vars) Decode(name string, raw []byte) (interface{}, error) {
+	v, ok := vars.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode var `%v`: not found in data layout", name)
+	}
+
+	return v.Decode(raw)
+}
+
+// String helps Vars to implement the Stringer interface.
+func (vars Vars) String() string {
+	s := make([]string, len(vars))
+	for i, v := range vars {
+		s[i] = v.String()
+	}
+
+	return fmt.Sprintf("[%v]", strings.Join(s, ", "))
+}
+
+// DataLayoutBuilder declares the named, typed variables of a data layout,
+// assigning their var IDs in declaration order. For example:
+//
+//	vars, err := NewDataLayoutBuilder().U32("counter").Address("owner").Build()
+//
+// Declaration errors are reported by Build.
+type DataLayoutBuilder struct {
+	vars Vars
+	err  error
+}
+
+// NewDataLayoutBuilder returns an empty DataLayoutBuilder.
+func NewDataLayoutBuilder() DataLayoutBuilder {
+	return DataLayoutBuilder{}
+}
+
+// U32 declares an `u32` var of 4 bytes.
+func (b DataLayoutBuilder) U32(name string) DataLayoutBuilder {
+	return b.declare(name, VarU32, 4)
+}
+
+// U64 declares an `u64` var of 8 bytes.
+func (b DataLayoutBuilder) U64(name string) DataLayoutBuilder {
+	return b.declare(name, VarU64, 8)
+}
+
+// Bool declares a `bool` var of 1 byte.
+func (b DataLayoutBuilder) Bool(name string) DataLayoutBuilder {
+	return b.declare(name, VarBool, 1)
+}
+
+// Address declares an `address` var of AddressLen bytes.
+func (b DataLayoutBuilder) Address(name string) DataLayoutBuilder {
+	return b.declare(name, VarAddress, AddressLen)
+}
+
+// Bytes declares a fixed-size byte array var.
+func (b DataLayoutBuilder) Bytes(name string, size uint32) DataLayoutBuilder {
+	if b.err == nil && size == 0 {
+		b.err = fmt.Errorf("var `%v` must have a positive size", name)
+		return b
+	}
+
+	return b.declare(name, VarBytes, size)
+}
+
+// Build returns the declared vars, or the first declaration error.
+func (b DataLayoutBuilder) Build() (Vars, error) {
+	if b.err != nil {
+		return nil, fmt.Errorf("failed to build data layout: %w", b.err)
+	}
+
+	return append(Vars{}, b.vars...), nil
+}
+
+func (b DataLayoutBuilder) declare(name string, typ VarType, size uint32) DataLayoutBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	switch _, exists := b.vars.Lookup(name); {
+	case name == "":
+		b.err = fmt.Errorf("var #%v must have a name", len(b.vars))
+		return b
+	case exists:
+		b.err = fmt.Errorf("duplicate var name `%v`", name)
+		return b
+	}
+
+	// Copy the vars, so that builders derived from the same one don't share them.
+	vars := make(Vars, len(b.vars), len(b.vars)+1)
+	copy(vars, b.vars)
+	b.vars = append(vars, Var{ID: uint32(len(vars)), Name: name, Type: typ, Size: size})
+
+	return b
+}
@@ -0,0 +1,155 @@
+package codec
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDataLayout_Encode(t *testing.T) {
+	req := require.New(t)
+
+	dl := DataLayout([]uint32{10, 20, 30})
+	b := dl.Encode()
+	req.Len(b, 12)
+	req.Equal(uint32(10), binary.BigEndian.Uint32(b[:]))
+	req.Equal(uint32(20), binary.BigEndian.Uint32(b[4:]))
+	req.Equal(uint32(30), binary.BigEndian.Uint32(b[8:]))
+}
+
+func TestDecodeDataLayout(t *testing.T) {
+	req := require.New(t)
+
+	dl := DataLayout{10, 20, 30}
+	decoded, err := DecodeDataLayout(dl.Encode())
+	req.NoError(err)
+	req.Equal(dl, decoded)
+
+	decoded, err = DecodeDataLayout(nil)
+	req.NoError(err)
+	req.Empty(decoded)
+
+	_, err = DecodeDataLayout([]byte{0, 0, 0, 4, 0})
+	req.EqualError(err, "failed to decode data layout: length must be a multiple of 4; given: 5")
+}
+
+func TestDataLayoutBuilder(t *testing.T) {
+	req := require.New(t)
+
+	vars, err := NewDataLayoutBuilder().
+		U32("counter").
+		U64("total").
+		Bool("paused").
+		Address("owner").
+		Bytes("hash", 32).
+		Build()
+	req.NoError(err)
+	req.Equal(Vars{
+		{ID: 0, Name: "counter", Type: VarU32, Size: 4},
+		{ID: 1, Name: "total", Type: VarU64, Size: 8},
+		{ID: 2, Name: "paused", Type: VarBool, Size: 1},
+		{ID: 3, Name: "owner", Type: VarAddress, Size: AddressLen},
+		{ID: 4, Name: "hash", Type: VarBytes, Size: 32},
+	}, vars)
+	req.Equal(DataLayout{4, 8, 1, 20, 32}, vars.DataLayout())
+	req.Equal("[#0 counter: u32, #1 total: u64, #2 paused: bool, #3 owner: address, #4 hash: bytes[32]]", vars.String())
+
+	v, ok := vars.Lookup("owner")
+	req.True(ok)
+	req.Equal(uint32(3), v.ID)
+	_, ok = vars.Lookup("missing")
+	req.False(ok)
+}
+
+func TestDataLayoutBuilder_Derived(t *testing.T) {
+	req := require.New(t)
+
+	base := NewDataLayoutBuilder().U32("a")
+	left, err := base.U32("b").Build()
+	req.NoError(err)
+	right, err := base.U64("c").Build()
+	req.NoError(err)
+
+	req.Equal(Vars{{ID: 0, Name: "a", Size: 4}, {ID: 1, Name: "b", Size: 4}}, left)
+	req.Equal(Vars{{ID: 0, Name: "a", Size: 4}, {ID: 1, Name: "c", Type: VarU64, Size: 8}}, right)
+}
+
+func TestDataLayoutBuilder_Errors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := NewDataLayoutBuilder().U32("a").Bool("a").Build()
+	req.EqualError(err, "failed to build data layout: duplicate var name `a`")
+
+	_, err = NewDataLayoutBuilder().U32("a").U64("").Build()
+	req.EqualError(err, "failed to build data layout: var #1 must have a name")
+
+	// The first error is the one reported.
+	_, err = NewDataLayoutBuilder().Bytes("a", 0).U32("").Build()
+	req.EqualError(err, "failed to build data layout: var `a` must have a positive size")
+}
+
+func TestVars_Decode(t *testing.T) {
+	req := require.New(t)
+
+	vars, err := NewDataLayoutBuilder().U32("n").U64("m").Bool("ok").Address("owner").Bytes("raw", 3).Build()
+	req.NoError(err)
+
+	raw := make([]byte, 8)
+	binary.BigEndian.PutUint32(raw, 7)
+	value, err := vars.Decode("n", raw[:4])
+	req.NoError(err)
+	req.Equal(uint32(7), value)
+
+	binary.BigEndian.PutUint64(raw, 1<<40)
+	value, err = vars.Decode("m", raw)
+	req.NoError(err)
+	req.Equal(uint64(1<<40), value)
+
+	value, err = vars.Decode("ok", []byte{1})
+	req.NoError(err)
+	req.Equal(true, value)
+
+	var owner Address
+	owner[0], owner[19] = 0xaa, 0xbb
+	value, err = vars.Decode("owner", owner[:])
+	req.NoError(err)
+	req.Equal(owner, value)
+
+	value, err = vars.Decode("raw", []byte{1, 2, 3})
+	req.NoError(err)
+	req.Equal([]byte{1, 2, 3}, value)
+
+	_, err = vars.Decode("n", []byte{1})
+	req.EqualError(err, "failed to decode var `n`: invalid size; expected: 4, given: 1")
+
+	_, err = vars.Decode("ok", []byte{2})
+	req.EqualError(err, "failed to decode var `ok`: invalid bool value 2")
+
+	_, err = vars.Decode("missing", nil)
+	req.EqualError(err, "failed to decode var `missing`: not found in data layout")
+}
+
+func TestVar_Encode(t *testing.T) {
+	req := require.New(t)
+
+	vars, err := NewDataLayoutBuilder().U32("n").U64("m").Bool("ok").Address("owner").Bytes("raw", 3).Build()
+	req.NoError(err)
+
+	var owner Address
+	owner[0] = 0xaa
+	for i, value := range []interface{}{uint32(7), uint64(1 << 40), true, owner, []byte{1, 2, 3}} {
+		raw, err := vars[i].Encode(value)
+		req.NoError(err)
+		req.Len(raw, int(vars[i].Size))
+
+		decoded, err := vars[i].Decode(raw)
+		req.NoError(err)
+		req.Equal(value, decoded)
+	}
+
+	_, err = vars[0].Encode(int32(7))
+	req.EqualError(err, "failed to encode var `n`: a u32 var can't hold a int32 value")
+
+	_, err = vars[4].Encode([]byte{1})
+	req.EqualError(err, "failed to encode var `raw`: invalid size; expected: 3, given: 1")
+}
@@ -0,0 +1,223 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// AppTemplate is a decoded raw `app-template` transaction.
+type AppTemplate struct {
+	Version    int
+	Name       string
+	Code       []byte
+	DataLayout DataLayout
+}
+
+// SpawnApp is a decoded raw `spawn-app` transaction.
+type SpawnApp struct {
+	Version      int
+	TemplateAddr Address
+	CtorIndex    uint16
+	CtorBuffer   []byte
+	CtorArgs     Values
+}
+
+// AppTx is a decoded raw `app-tx` transaction.
+type AppTx struct {
+	Version    int
+	AppAddr    Address
+	FuncIndex  uint16
+	FuncBuffer []byte
+	FuncArgs   Values
+}
+
+// DecodeAppTemplate decodes a raw `app-template` transaction according to the encoding format
+// defined in EncodeAppTemplate.
+func DecodeAppTemplate(data []byte) (*AppTemplate, error) {
+	if len(data) == 0 {
+		return nil, errors.New("invalid input: empty data")
+	}
+
+	d := decoder{bytes.NewBuffer(data)}
+
+	version, err := d.uint32("version")
+	if err != nil {
+		return nil, err
+	}
+
+	nameLen, err := d.next("name length", 1)
+	if err != nil {
+		return nil, err
+	}
+	name, err := d.bytes("name", uint32(nameLen[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	codeLen, err := d.uint32("code length")
+	if err != nil {
+		return nil, err
+	}
+	code, err := d.bytes("code", codeLen)
+	if err != nil {
+		return nil, err
+	}
+
+	numVars, err := d.uint16("#vars")
+	if err != nil {
+		return nil, err
+	}
+	dataLayout := make(DataLayout, numVars)
+	for i := range dataLayout {
+		if dataLayout[i], err = d.uint32(fmt.Sprintf("var #%v size", i)); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.buf.Len() > 0 {
+		return nil, fmt.Errorf("too many bytes; num expected: %v, num given: %v",
+			len(data)-d.buf.Len(), len(data))
+	}
+
+	return &AppTemplate{
+		Version:    int(version),
+		Name:       string(name),
+		Code:       append([]byte{}, code...),
+		DataLayout: dataLayout,
+	}, nil
+}
+
+// DecodeSpawnApp decodes a raw `spawn-app` transaction according to the encoding format
+// defined in EncodeSpawnApp.
+func DecodeSpawnApp(data []byte) (*SpawnApp, error) {
+	version, templateAddr, ctorIndex, ctorBuffer, ctorArgs, err := decodeCall(data, "template address", "ctor")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SpawnApp{
+		Version:      version,
+		TemplateAddr: templateAddr,
+		CtorIndex:    ctorIndex,
+		CtorBuffer:   ctorBuffer,
+		CtorArgs:     ctorArgs,
+	}, nil
+}
+
+// DecodeAppTx decodes a raw `app-tx` transaction according to the encoding format
+// defined in EncodeAppTx.
+func DecodeAppTx(data []byte) (*AppTx, error) {
+	version, appAddr, funcIndex, funcBuffer, funcArgs, err := decodeCall(data, "app address", "func")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppTx{
+		Version:    version,
+		AppAddr:    appAddr,
+		FuncIndex:  funcIndex,
+		FuncBuffer: funcBuffer,
+		FuncArgs:   funcArgs,
+	}, nil
+}
+
+// decodeCall decodes the layout shared by `spawn-app` and `app-tx`.
+// The addrName and funcName are used for naming the fields in errors.
+func decodeCall(data []byte, addrName, funcName string) (
+	version int, addr Address, funcIndex uint16, funcBuffer []byte, funcArgs Values, err error) {
+
+	if len(data) == 0 {
+		err = errors.New("invalid input: empty data")
+		return
+	}
+
+	d := decoder{bytes.NewBuffer(data)}
+
+	v, err := d.uint32("version")
+	if err != nil {
+		return
+	}
+	version = int(v)
+
+	b, err := d.next(addrName, AddressLen)
+	if err != nil {
+		return
+	}
+	copy(addr[:], b)
+
+	if funcIndex, err = d.uint16(funcName + " index"); err != nil {
+		return
+	}
+
+	bufferLen, err := d.uint32(funcName + " buffer length")
+	if err != nil {
+		return
+	}
+	if b, err = d.bytes(funcName+" buffer", bufferLen); err != nil {
+		return
+	}
+	funcBuffer = append([]byte{}, b...)
+
+	if err = (&funcArgs).Decode(d.buf.Bytes()); err != nil {
+		err = fmt.Errorf("failed to decode %v args: %v", funcName, err)
+		return
+	}
+
+	return
+}
+
+// decoder reads fixed-size fields out of a buffer,
+// and reports the missing bytes of a truncated field.
+type decoder struct {
+	buf *bytes.Buffer
+}
+
+func (d decoder) next(field string, n int) ([]byte, error) {
+	next := d.buf.Next(n)
+	if len(next) < n {
+		return nil, fmt.Errorf("failed to decode %v: "+
+			"bytes are missing; expected: %v, given: %v", field, n, len(next))
+	}
+
+	return next, nil
+}
+
+// bytes reads a field of a length decoded out of the data. The length is checked against
+// the remaining bytes before it's converted to an int, which may be 32 bits long.
+func (d decoder) bytes(field string, n uint32) ([]byte, error) {
+	if uint64(n) > uint64(d.buf.Len()) {
+		return nil, fmt.Errorf("failed to decode %v: "+
+			"bytes are missing; expected: %v, given: %v", field, n, d.buf.Len())
+	}
+
+	return d.next(field, int(n))
+}
+
+func (d decoder) uint16(field string) (uint16, error) {
+	b, err := d.next(field, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint16(b), nil
+}
+
+func (d decoder) uint32(field string) (uint32, error) {
+	b, err := d.next(field, 4)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint32(b), nil
+}
+
+func (d decoder) uint64(field string) (uint64, error) {
+	b, err := d.next(field, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(b), nil
+}
@@ -0,0 +1,137 @@
+package codec
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDecodeAppTemplate(t *testing.T) {
+	req := require.New(t)
+
+	data, err := EncodeAppTemplate(1, "name", []byte{0xAA, 0xBB}, DataLayout{4, 20})
+	req.NoError(err)
+
+	appTemplate, err := DecodeAppTemplate(data)
+	req.NoError(err)
+	req.Equal(&AppTemplate{
+		Version:    1,
+		Name:       "name",
+		Code:       []byte{0xAA, 0xBB},
+		DataLayout: DataLayout{4, 20},
+	}, appTemplate)
+}
+
+func TestDecodeAppTemplate_Errors(t *testing.T) {
+	req := require.New(t)
+
+	data, err := EncodeAppTemplate(1, "name", []byte{0xAA, 0xBB}, DataLayout{4, 20})
+	req.NoError(err)
+
+	_, err = DecodeAppTemplate(nil)
+	req.EqualError(err, "invalid input: empty data")
+
+	_, err = DecodeAppTemplate(data[:3])
+	req.EqualError(err, "failed to decode version: bytes are missing; expected: 4, given: 3")
+
+	_, err = DecodeAppTemplate(data[:4])
+	req.EqualError(err, "failed to decode name length: bytes are missing; expected: 1, given: 0")
+
+	_, err = DecodeAppTemplate(data[:7])
+	req.EqualError(err, "failed to decode name: bytes are missing; expected: 4, given: 2")
+
+	_, err = DecodeAppTemplate(data[:14])
+	req.EqualError(err, "failed to decode code: bytes are missing; expected: 2, given: 1")
+
+	_, err = DecodeAppTemplate(data[:16])
+	req.EqualError(err, "failed to decode #vars: bytes are missing; expected: 2, given: 1")
+
+	_, err = DecodeAppTemplate(data[:len(data)-1])
+	req.EqualError(err, "failed to decode var #1 size: bytes are missing; expected: 4, given: 3")
+
+	_, err = DecodeAppTemplate(append(data, 0))
+	req.EqualError(err, "too many bytes; num expected: 25, num given: 26")
+}
+
+func TestDecodeSpawnApp(t *testing.T) {
+	req := require.New(t)
+
+	templateAddr := Address{1, 2, 3}
+	data, err := EncodeSpawnApp(0, templateAddr, 2, []byte{0xFF}, Values{I32(5), I64(-1)})
+	req.NoError(err)
+
+	spawnApp, err := DecodeSpawnApp(data)
+	req.NoError(err)
+	req.Equal(&SpawnApp{
+		Version:      0,
+		TemplateAddr: templateAddr,
+		CtorIndex:    2,
+		CtorBuffer:   []byte{0xFF},
+		CtorArgs:     Values{I32(5), I64(-1)},
+	}, spawnApp)
+}
+
+func TestDecodeSpawnApp_Errors(t *testing.T) {
+	req := require.New(t)
+
+	data, err := EncodeSpawnApp(0, Address{}, 2, []byte{0xFF}, Values{I32(5)})
+	req.NoError(err)
+
+	_, err = DecodeSpawnApp(data[:10])
+	req.EqualError(err, "failed to decode template address: bytes are missing; expected: 20, given: 6")
+
+	_, err = DecodeSpawnApp(data[:25])
+	req.EqualError(err, "failed to decode ctor index: bytes are missing; expected: 2, given: 1")
+
+	_, err = DecodeSpawnApp(data[:29])
+	req.EqualError(err, "failed to decode ctor buffer length: bytes are missing; expected: 4, given: 3")
+
+	_, err = DecodeSpawnApp(data[:30])
+	req.EqualError(err, "failed to decode ctor buffer: bytes are missing; expected: 1, given: 0")
+
+	_, err = DecodeSpawnApp(data[:31])
+	req.EqualError(err, "failed to decode ctor args: invalid input: empty data")
+
+	_, err = DecodeSpawnApp(data[:len(data)-1])
+	req.EqualError(err, "failed to decode ctor args: failed to decode value #0: bytes are missing; expected: 4, given: 3")
+}
+
+func TestDecodeAppTx(t *testing.T) {
+	req := require.New(t)
+
+	appAddr := Address{0xFF}
+	data, err := EncodeAppTx(3, appAddr, 1, nil, nil)
+	req.NoError(err)
+
+	appTx, err := DecodeAppTx(data)
+	req.NoError(err)
+	req.Equal(&AppTx{
+		Version:    3,
+		AppAddr:    appAddr,
+		FuncIndex:  1,
+		FuncBuffer: []byte{},
+		FuncArgs:   Values{},
+	}, appTx)
+
+	_, err = DecodeAppTx(append(data, 0))
+	req.EqualError(err, "failed to decode func args: too many bytes; num expected: 1, num given: 2")
+}
+
+func TestDecode_OversizedLength(t *testing.T) {
+	req := require.New(t)
+
+	// A length beyond the data is reported as missing bytes, whatever the int size is.
+	data := []byte{
+		0, 0, 0, 0, // version
+		0,                      // name length
+		0xFF, 0xFF, 0xFF, 0xFF, // code length
+		0xAA,
+	}
+	_, err := DecodeAppTemplate(data)
+	req.EqualError(err, "failed to decode code: bytes are missing; expected: 4294967295, given: 1")
+
+	data, err = EncodeAppTx(0, Address{}, 0, nil, nil)
+	req.NoError(err)
+	copy(data[26:30], []byte{0xFF, 0xFF, 0xFF, 0xFF})
+	_, err = DecodeAppTx(data)
+	req.EqualError(err, "failed to decode func buffer: bytes are missing; expected: 4294967295, given: 1")
+}
@@ -0,0 +1,10 @@
+// Package codec implements the SVM raw transactions encoding in pure Go.
+//
+// Unlike the parent `svm` package, this package doesn't use cgo,
+// and doesn't require the SVM runtime shared library to be loaded.
+// Hence, it can be used for building transactions by wallets and services
+// which never execute them.
+//
+// The `svm` package re-exports the types defined here,
+// so values created by either package can be used interchangeably.
+package codec
@@ -0,0 +1,138 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// EncodeAppTemplate encodes a raw `app-template` transaction according to the following format:
+//
+// +-------------------------------------------------------------+
+// |  version   |  name length  |     name      |  code length   |
+// | (4 bytes)  |   (1 byte)    | (name length  |   (4 bytes)    |
+// |            |               |    bytes)     |                |
+// +-------------------------------------------------------------+
+// |     code      |   #vars    |  var #1 size  | . . . | var #N  |
+// | (code length  | (2 bytes)  |   (4 bytes)   |       |  size   |
+// |    bytes)     |            |               |       |(4 bytes)|
+// +-------------------------------------------------------------+
+//
+// All numbers byte order is Big-Endian.
+// The output must be identical to the SVM runtime `svm_encode_app_template` one. It is checked by
+// TestEncodingVectors, against vectors recorded out of the runtime (see `just record-vectors`).
+func EncodeAppTemplate(version int, name string, code []byte, dataLayout DataLayout) ([]byte, error) {
+	if err := checkVersion(version); err != nil {
+		return nil, err
+	}
+	if len(name) > math.MaxUint8 {
+		return nil, fmt.Errorf("name is too long; max: %v, given: %v", math.MaxUint8, len(name))
+	}
+	if uint64(len(code)) > math.MaxUint32 {
+		return nil, fmt.Errorf("code is too long; max: %v, given: %v", uint32(math.MaxUint32), len(code))
+	}
+	if len(dataLayout) > math.MaxUint16 {
+		return nil, fmt.Errorf("data layout has too many vars; max: %v, given: %v", math.MaxUint16, len(dataLayout))
+	}
+
+	buf := &bytes.Buffer{}
+
+	writeUint32(buf, uint32(version))
+
+	buf.WriteByte(byte(len(name)))
+	buf.WriteString(name)
+
+	writeUint32(buf, uint32(len(code)))
+	buf.Write(code)
+
+	writeUint16(buf, uint16(len(dataLayout)))
+	buf.Write(dataLayout.Encode())
+
+	return buf.Bytes(), nil
+}
+
+// EncodeSpawnApp encodes a raw `spawn-app` transaction according to the following format:
+//
+// +--------------------------------------------------------------+
+// |  version   |  template address  |  ctor index  |  ctor buffer |
+// | (4 bytes)  |    (20 bytes)      |  (2 bytes)   |    length    |
+// |            |                    |              |  (4 bytes)   |
+// +--------------------------------------------------------------+
+// |    ctor buffer      |          ctor args                      |
+// | (ctor buffer length |  (Values encoding)                      |
+// |      bytes)         |                                         |
+// +--------------------------------------------------------------+
+//
+// All numbers byte order is Big-Endian.
+// The output must be identical to the SVM runtime `svm_encode_spawn_app` one. It is checked by
+// TestEncodingVectors, against vectors recorded out of the runtime (see `just record-vectors`).
+func EncodeSpawnApp(version int, templateAddr Address, ctorIndex uint16, ctorBuffer []byte, ctorArgs Values) ([]byte, error) {
+	return encodeCall(version, templateAddr, ctorIndex, ctorBuffer, ctorArgs)
+}
+
+// EncodeAppTx encodes a raw `app-tx` transaction according to the following format:
+//
+// +--------------------------------------------------------------+
+// |  version   |    app address     |  func index  |  func buffer |
+// | (4 bytes)  |    (20 bytes)      |  (2 bytes)   |    length    |
+// |            |                    |              |  (4 bytes)   |
+// +--------------------------------------------------------------+
+// |    func buffer      |          func args                      |
+// | (func buffer length |  (Values encoding)                      |
+// |      bytes)         |                                         |
+// +--------------------------------------------------------------+
+//
+// All numbers byte order is Big-Endian.
+// The output must be identical to the SVM runtime `svm_encode_app_tx` one. It is checked by
+// TestEncodingVectors, against vectors recorded out of the runtime (see `just record-vectors`).
+func EncodeAppTx(version int, appAddr Address, funcIndex uint16, funcBuffer []byte, funcArgs Values) ([]byte, error) {
+	return encodeCall(version, appAddr, funcIndex, funcBuffer, funcArgs)
+}
+
+// encodeCall encodes the layout shared by `spawn-app` and `app-tx`,
+// which both call a function (either a constructor or a regular one) of a given address.
+func encodeCall(version int, addr Address, funcIndex uint16, funcBuffer []byte, funcArgs Values) ([]byte, error) {
+	if err := checkVersion(version); err != nil {
+		return nil, err
+	}
+	if uint64(len(funcBuffer)) > math.MaxUint32 {
+		return nil, fmt.Errorf("buffer is too long; max: %v, given: %v", uint32(math.MaxUint32), len(funcBuffer))
+	}
+	if len(funcArgs) > math.MaxUint8 {
+		return nil, fmt.Errorf("too many args; max: %v, given: %v", math.MaxUint8, len(funcArgs))
+	}
+
+	buf := &bytes.Buffer{}
+
+	writeUint32(buf, uint32(version))
+	buf.Write(addr[:])
+	writeUint16(buf, funcIndex)
+
+	writeUint32(buf, uint32(len(funcBuffer)))
+	buf.Write(funcBuffer)
+
+	buf.Write(funcArgs.Encode())
+
+	return buf.Bytes(), nil
+}
+
+func checkVersion(version int) error {
+	if version < 0 || uint64(version) > math.MaxUint32 {
+		return fmt.Errorf("invalid version; must be in range [0, %v], given: %v", uint32(math.MaxUint32), version)
+	}
+
+	return nil
+}
+
+func writeUint16(buf *bytes.Buffer, v uint16) {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	buf.Write(b)
+}
+
+func writeUint32(buf *bytes.Buffer, v uint32) {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	buf.Write(b)
+}
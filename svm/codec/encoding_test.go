@@ -0,0 +1,69 @@
+package codec
+
+import (
+	"github.com/stretchr/testify/require"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEncodeAppTemplate(t *testing.T) {
+	req := require.New(t)
+
+	b, err := EncodeAppTemplate(1, "ab", []byte{0xAA, 0xBB, 0xCC}, DataLayout{4, 8})
+	req.NoError(err)
+	req.Equal([]byte{
+		0, 0, 0, 1, // version
+		2, 'a', 'b', // name
+		0, 0, 0, 3, 0xAA, 0xBB, 0xCC, // code
+		0, 2, 0, 0, 0, 4, 0, 0, 0, 8, // data layout
+	}, b)
+}
+
+func TestEncodeAppTemplate_Errors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := EncodeAppTemplate(-1, "", nil, nil)
+	req.EqualError(err, "invalid version; must be in range [0, 4294967295], given: -1")
+
+	_, err = EncodeAppTemplate(0, strings.Repeat("a", math.MaxUint8+1), nil, nil)
+	req.EqualError(err, "name is too long; max: 255, given: 256")
+
+	_, err = EncodeAppTemplate(0, "", nil, make(DataLayout, math.MaxUint16+1))
+	req.EqualError(err, "data layout has too many vars; max: 65535, given: 65536")
+}
+
+func TestEncodeSpawnApp(t *testing.T) {
+	req := require.New(t)
+
+	templateAddr := Address{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}
+	b, err := EncodeSpawnApp(0, templateAddr, 3, []byte{0xAA}, Values{I32(5)})
+	req.NoError(err)
+	req.Equal([]byte{
+		0, 0, 0, 0, // version
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, // template address
+		0, 3, // ctor index
+		0, 0, 0, 1, 0xAA, // ctor buffer
+		1, byte(TypeI32), 0, 0, 0, 5, // ctor args
+	}, b)
+}
+
+func TestEncodeAppTx(t *testing.T) {
+	req := require.New(t)
+
+	appAddr := Address{0xFF}
+	b, err := EncodeAppTx(2, appAddr, 0x0102, nil, nil)
+	req.NoError(err)
+
+	expected := []byte{0, 0, 0, 2}
+	expected = append(expected, appAddr[:]...)
+	expected = append(expected,
+		1, 2, // func index
+		0, 0, 0, 0, // func buffer
+		0, // func args
+	)
+	req.Equal(expected, b)
+
+	_, err = EncodeAppTx(0, appAddr, 0, nil, make(Values, math.MaxUint8+1))
+	req.EqualError(err, "too many args; max: 255, given: 256")
+}
@@ -0,0 +1,309 @@
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// stateLen is the byte length of an app state.
+const stateLen = 32
+
+// receiptType identifies the transaction type a receipt was produced for.
+type receiptType uint8
+
+const (
+	receiptTypeDeployTemplate receiptType = 0
+	receiptTypeSpawnApp       receiptType = 1
+	receiptTypeExecApp        receiptType = 2
+)
+
+// String helps receiptType to implement the Stringer interface.
+func (t receiptType) String() string {
+	switch t {
+	case receiptTypeDeployTemplate:
+		return "deploy-template"
+	case receiptTypeSpawnApp:
+		return "spawn-app"
+	case receiptTypeExecApp:
+		return "exec-app"
+	default:
+		return fmt.Sprintf("unknown (%d)", uint8(t))
+	}
+}
+
+// receiptErrorKind represents the reason a transaction failed, as reported by its receipt.
+type receiptErrorKind uint8
+
+const (
+	receiptErrOutOfGas            receiptErrorKind = 0
+	receiptErrTemplateNotFound    receiptErrorKind = 1
+	receiptErrAppNotFound         receiptErrorKind = 2
+	receiptErrCompilationFailed   receiptErrorKind = 3
+	receiptErrInstantiationFailed receiptErrorKind = 4
+	receiptErrFuncNotFound        receiptErrorKind = 5
+	receiptErrFuncFailed          receiptErrorKind = 6
+)
+
+// String helps receiptErrorKind to implement the Stringer interface.
+func (k receiptErrorKind) String() string {
+	switch k {
+	case receiptErrOutOfGas:
+		return "out of gas"
+	case receiptErrTemplateNotFound:
+		return "template not found"
+	case receiptErrAppNotFound:
+		return "app not found"
+	case receiptErrCompilationFailed:
+		return "compilation failed"
+	case receiptErrInstantiationFailed:
+		return "instantiation failed"
+	case receiptErrFuncNotFound:
+		return "function not found"
+	case receiptErrFuncFailed:
+		return "function failed"
+	default:
+		return fmt.Sprintf("unknown (%d)", uint8(k))
+	}
+}
+
+// receiptLog is a log entry emitted during a transaction execution.
+type receiptLog struct {
+	Msg  []byte
+	Code byte
+}
+
+// receiptHeader holds the fields shared by all receipt types.
+// When the transaction failed (Success is false), ErrKind and ErrMsg
+// hold the failure reason, and the type-specific fields are not set.
+type receiptHeader struct {
+	Version int
+	Success bool
+	ErrKind receiptErrorKind
+	ErrMsg  string
+	Logs    []receiptLog
+}
+
+// deployReceipt is a decoded `deploy-template` receipt.
+type deployReceipt struct {
+	receiptHeader
+	TemplateAddr Address
+	GasUsed      uint64
+}
+
+// spawnReceipt is a decoded `spawn-app` receipt.
+type spawnReceipt struct {
+	receiptHeader
+	AppAddr      Address
+	InitialState []byte
+	Returns      Values
+	GasUsed      uint64
+}
+
+// execReceipt is a decoded `exec-app` receipt.
+type execReceipt struct {
+	receiptHeader
+	NewState []byte
+	Returns  Values
+	GasUsed  uint64
+}
+
+// Receipts are encoded according to the following format:
+//
+// +--------------------------------------------------------------+
+// |  receipt type  |  version   |  is success  |  type-specific  |
+// |    (1 byte)    | (4 bytes)  |   (1 byte)   |     fields      |
+// +--------------------------------------------------------------+
+// |    #logs    |  log #1 msg   |  log #1 msg  |  log #1  | . . . |
+// |  (1 byte)   |    length     |              |   code   |       |
+// |             |  (2 bytes)    |              | (1 byte) |       |
+// +--------------------------------------------------------------+
+//
+// For a failed transaction, the type-specific fields are:
+//
+// +--------------------------------------------+
+// |  error kind  |  error msg  |   error msg    |
+// |   (1 byte)   |   length    |                |
+// |              |  (2 bytes)  |                |
+// +--------------------------------------------+
+//
+// Otherwise, they are (by receipt type):
+//
+//   deploy-template: template address (20 bytes), gas used (8 bytes).
+//   spawn-app:       app address (20 bytes), initial state (32 bytes),
+//                    returns (Values encoding), gas used (8 bytes).
+//   exec-app:        new state (32 bytes), returns (Values encoding), gas used (8 bytes).
+//
+// All numbers byte order is Big-Endian.
+//
+// This layout, stateLen and the receiptErrorKind numbering are meant to follow the receipt
+// encoding of the SVM runtime revision pinned by `svm-dep/Cargo.lock`, but they aren't taken
+// from its source. So the parsers are unexported, and the svm package commands decode receipts
+// via the runtime receipt helpers, until they're checked by TestReceiptVectors against receipts
+// recorded out of the runtime (see `just record-vectors`).
+
+// parseDeployReceipt decodes a `deploy-template` receipt in a single pass.
+func parseDeployReceipt(receipt []byte) (*deployReceipt, error) {
+	r := &deployReceipt{}
+
+	err := parseReceipt(receipt, receiptTypeDeployTemplate, &r.receiptHeader, func(d decoder) error {
+		b, err := d.next("template address", AddressLen)
+		if err != nil {
+			return err
+		}
+		copy(r.TemplateAddr[:], b)
+
+		r.GasUsed, err = d.uint64("gas used")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// parseSpawnReceipt decodes a `spawn-app` receipt in a single pass.
+func parseSpawnReceipt(receipt []byte) (*spawnReceipt, error) {
+	r := &spawnReceipt{}
+
+	err := parseReceipt(receipt, receiptTypeSpawnApp, &r.receiptHeader, func(d decoder) error {
+		b, err := d.next("app address", AddressLen)
+		if err != nil {
+			return err
+		}
+		copy(r.AppAddr[:], b)
+
+		if b, err = d.next("initial state", stateLen); err != nil {
+			return err
+		}
+		r.InitialState = append([]byte{}, b...)
+
+		if r.Returns, err = readValues(d.buf); err != nil {
+			return fmt.Errorf("failed to decode returns: %v", err)
+		}
+
+		r.GasUsed, err = d.uint64("gas used")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// parseExecReceipt decodes an `exec-app` receipt in a single pass.
+func parseExecReceipt(receipt []byte) (*execReceipt, error) {
+	r := &execReceipt{}
+
+	err := parseReceipt(receipt, receiptTypeExecApp, &r.receiptHeader, func(d decoder) error {
+		b, err := d.next("new state", stateLen)
+		if err != nil {
+			return err
+		}
+		r.NewState = append([]byte{}, b...)
+
+		if r.Returns, err = readValues(d.buf); err != nil {
+			return fmt.Errorf("failed to decode returns: %v", err)
+		}
+
+		r.GasUsed, err = d.uint64("gas used")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// parseReceipt decodes the receipt fields shared by all receipt types into h,
+// and calls parseSuccess for decoding the type-specific fields of a successful transaction.
+func parseReceipt(receipt []byte, ty receiptType, h *receiptHeader, parseSuccess func(d decoder) error) error {
+	if len(receipt) == 0 {
+		return errors.New("invalid receipt: empty data")
+	}
+
+	d := decoder{bytes.NewBuffer(receipt)}
+
+	b, err := d.next("receipt type", 1)
+	if err != nil {
+		return err
+	}
+	if receiptType(b[0]) != ty {
+		return fmt.Errorf("invalid receipt type; expected: %v, given: %v", ty, receiptType(b[0]))
+	}
+
+	version, err := d.uint32("version")
+	if err != nil {
+		return err
+	}
+	h.Version = int(version)
+
+	if b, err = d.next("is success", 1); err != nil {
+		return err
+	}
+
+	switch b[0] {
+	case 0:
+		if b, err = d.next("error kind", 1); err != nil {
+			return err
+		}
+		h.ErrKind = receiptErrorKind(b[0])
+
+		msgLen, err := d.uint16("error msg length")
+		if err != nil {
+			return err
+		}
+		if b, err = d.bytes("error msg", uint32(msgLen)); err != nil {
+			return err
+		}
+		h.ErrMsg = string(b)
+	case 1:
+		h.Success = true
+		if err := parseSuccess(d); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid is success value; expected: 0 or 1, given: %v", b[0])
+	}
+
+	if h.Logs, err = readLogs(d); err != nil {
+		return err
+	}
+
+	if d.buf.Len() > 0 {
+		return fmt.Errorf("too many bytes; num expected: %v, num given: %v",
+			len(receipt)-d.buf.Len(), len(receipt))
+	}
+
+	return nil
+}
+
+func readLogs(d decoder) ([]receiptLog, error) {
+	b, err := d.next("#logs", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]receiptLog, b[0])
+	for i := range logs {
+		msgLen, err := d.uint16(fmt.Sprintf("log #%v msg length", i))
+		if err != nil {
+			return nil, err
+		}
+		msg, err := d.bytes(fmt.Sprintf("log #%v msg", i), uint32(msgLen))
+		if err != nil {
+			return nil, err
+		}
+		code, err := d.next(fmt.Sprintf("log #%v code", i), 1)
+		if err != nil {
+			return nil, err
+		}
+
+		logs[i] = receiptLog{Msg: append([]byte{}, msg...), Code: code[0]}
+	}
+
+	return logs, nil
+}
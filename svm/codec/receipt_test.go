@@ -0,0 +1,163 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// receiptBuilder builds raw receipts for the tests, according to the receipts encoding format.
+type receiptBuilder struct {
+	bytes.Buffer
+}
+
+func newReceiptBuilder(ty receiptType, version uint32, success bool) *receiptBuilder {
+	rb := &receiptBuilder{}
+	rb.WriteByte(byte(ty))
+	writeUint32(&rb.Buffer, version)
+	if success {
+		rb.WriteByte(1)
+	} else {
+		rb.WriteByte(0)
+	}
+	return rb
+}
+
+func (rb *receiptBuilder) error(kind receiptErrorKind, msg string) *receiptBuilder {
+	rb.WriteByte(byte(kind))
+	writeUint16(&rb.Buffer, uint16(len(msg)))
+	rb.WriteString(msg)
+	return rb
+}
+
+func (rb *receiptBuilder) gas(gasUsed uint64) *receiptBuilder {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, gasUsed)
+	rb.Write(b)
+	return rb
+}
+
+func (rb *receiptBuilder) logs(logs ...receiptLog) []byte {
+	rb.WriteByte(byte(len(logs)))
+	for _, log := range logs {
+		writeUint16(&rb.Buffer, uint16(len(log.Msg)))
+		rb.Write(log.Msg)
+		rb.WriteByte(log.Code)
+	}
+	return rb.Bytes()
+}
+
+func testState(b byte) []byte {
+	return bytes.Repeat([]byte{b}, stateLen)
+}
+
+func TestParseDeployReceipt(t *testing.T) {
+	req := require.New(t)
+
+	addr := Address{1, 2, 3}
+	rb := newReceiptBuilder(receiptTypeDeployTemplate, 0, true)
+	rb.Write(addr[:])
+	receipt := rb.gas(1000).logs()
+
+	r, err := parseDeployReceipt(receipt)
+	req.NoError(err)
+	req.Equal(&deployReceipt{
+		receiptHeader: receiptHeader{Success: true, Logs: []receiptLog{}},
+		TemplateAddr:  addr,
+		GasUsed:       1000,
+	}, r)
+}
+
+func TestParseSpawnReceipt(t *testing.T) {
+	req := require.New(t)
+
+	addr := Address{0xFF}
+	rb := newReceiptBuilder(receiptTypeSpawnApp, 1, true)
+	rb.Write(addr[:])
+	rb.Write(testState(0xAA))
+	rb.Write(Values{I32(5)}.Encode())
+	receipt := rb.gas(20).logs(receiptLog{Msg: []byte("ctor"), Code: 7})
+
+	r, err := parseSpawnReceipt(receipt)
+	req.NoError(err)
+	req.Equal(&spawnReceipt{
+		receiptHeader: receiptHeader{Version: 1, Success: true, Logs: []receiptLog{{Msg: []byte("ctor"), Code: 7}}},
+		AppAddr:       addr,
+		InitialState:  testState(0xAA),
+		Returns:       Values{I32(5)},
+		GasUsed:       20,
+	}, r)
+}
+
+func TestParseExecReceipt(t *testing.T) {
+	req := require.New(t)
+
+	rb := newReceiptBuilder(receiptTypeExecApp, 0, true)
+	rb.Write(testState(0xBB))
+	rb.Write(Values{I64(-1), I32(2)}.Encode())
+	receipt := rb.gas(30).logs()
+
+	r, err := parseExecReceipt(receipt)
+	req.NoError(err)
+	req.Equal(&execReceipt{
+		receiptHeader: receiptHeader{Success: true, Logs: []receiptLog{}},
+		NewState:      testState(0xBB),
+		Returns:       Values{I64(-1), I32(2)},
+		GasUsed:       30,
+	}, r)
+}
+
+func TestParseExecReceipt_Failure(t *testing.T) {
+	req := require.New(t)
+
+	receipt := newReceiptBuilder(receiptTypeExecApp, 0, false).
+		error(receiptErrFuncFailed, "unreachable").
+		logs(receiptLog{Msg: []byte("before trap"), Code: 1})
+
+	r, err := parseExecReceipt(receipt)
+	req.NoError(err)
+	req.Equal(&execReceipt{
+		receiptHeader: receiptHeader{
+			ErrKind: receiptErrFuncFailed,
+			ErrMsg:  "unreachable",
+			Logs:    []receiptLog{{Msg: []byte("before trap"), Code: 1}},
+		},
+	}, r)
+	req.Equal("function failed", r.ErrKind.String())
+}
+
+func TestParseReceipt_Errors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := parseExecReceipt(nil)
+	req.EqualError(err, "invalid receipt: empty data")
+
+	deployReceipt := newReceiptBuilder(receiptTypeDeployTemplate, 0, true).logs()
+	_, err = parseExecReceipt(deployReceipt)
+	req.EqualError(err, "invalid receipt type; expected: exec-app, given: deploy-template")
+
+	_, err = parseDeployReceipt(deployReceipt[:3])
+	req.EqualError(err, "failed to decode version: bytes are missing; expected: 4, given: 2")
+
+	_, err = parseDeployReceipt(deployReceipt)
+	req.EqualError(err, "failed to decode template address: bytes are missing; expected: 20, given: 1")
+
+	rb := newReceiptBuilder(receiptTypeExecApp, 0, true)
+	rb.Write(testState(0))
+	rb.Write([]byte{1, byte(TypeI32), 0})
+	_, err = parseExecReceipt(rb.Bytes())
+	req.EqualError(err, "failed to decode returns: failed to decode value #0: bytes are missing; expected: 4, given: 1")
+
+	receipt := newReceiptBuilder(receiptTypeExecApp, 0, false).error(receiptErrOutOfGas, "").logs()
+	_, err = parseExecReceipt(append(receipt, 0))
+	req.EqualError(err, "too many bytes; num expected: 10, num given: 11")
+
+	receipt = newReceiptBuilder(receiptTypeExecApp, 0, false).error(receiptErrOutOfGas, "").logs(receiptLog{Msg: []byte("msg")})
+	_, err = parseExecReceipt(receipt[:len(receipt)-1])
+	req.EqualError(err, "failed to decode log #0 code: bytes are missing; expected: 1, given: 0")
+
+	receipt = []byte{byte(receiptTypeExecApp), 0, 0, 0, 0, 2}
+	_, err = parseExecReceipt(receipt)
+	req.EqualError(err, "invalid is success value; expected: 0 or 1, given: 2")
+}
@@ -1,4 +1,4 @@
-package svm
+package codec
 
 import (
 	"github.com/stretchr/testify/require"
@@ -0,0 +1,188 @@
+package codec
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The vectors are recorded out of the SVM runtime library by the svm package
+// TestRecordVectors test (see `just record-vectors`), so the tests below check
+// the pure-Go encoding and receipt parsers against the runtime without cgo.
+
+type encodingVectors struct {
+	AppTemplates []appTemplateVector `json:"app_templates"`
+	SpawnApps    []callVector        `json:"spawn_apps"`
+	AppTxs       []callVector        `json:"app_txs"`
+}
+
+type appTemplateVector struct {
+	Version    int        `json:"version"`
+	Name       string     `json:"name"`
+	Code       []byte     `json:"code"`
+	DataLayout DataLayout `json:"data_layout"`
+	Encoded    []byte     `json:"encoded"`
+}
+
+type callVector struct {
+	Version    int     `json:"version"`
+	Addr       Address `json:"addr"`
+	FuncIndex  uint16  `json:"func_index"`
+	FuncBuffer []byte  `json:"func_buffer"`
+	FuncArgs   []byte  `json:"func_args"`
+	Encoded    []byte  `json:"encoded"`
+}
+
+type receiptVectors struct {
+	Deploys []deployReceiptVector `json:"deploys"`
+	Spawns  []spawnReceiptVector  `json:"spawns"`
+	Execs   []execReceiptVector   `json:"execs"`
+}
+
+type deployReceiptVector struct {
+	Receipt      []byte  `json:"receipt"`
+	Success      bool    `json:"success"`
+	TemplateAddr Address `json:"template_addr"`
+	GasUsed      uint64  `json:"gas_used"`
+}
+
+type spawnReceiptVector struct {
+	Receipt      []byte  `json:"receipt"`
+	Success      bool    `json:"success"`
+	AppAddr      Address `json:"app_addr"`
+	InitialState []byte  `json:"initial_state"`
+	Returns      []byte  `json:"returns"`
+	GasUsed      uint64  `json:"gas_used"`
+}
+
+type execReceiptVector struct {
+	Receipt  []byte `json:"receipt"`
+	Success  bool   `json:"success"`
+	NewState []byte `json:"new_state"`
+	Returns  []byte `json:"returns"`
+	GasUsed  uint64 `json:"gas_used"`
+}
+
+// readVectors reads the vectors file of the given name into v.
+// The test is skipped when the vectors haven't been recorded yet.
+func readVectors(t *testing.T, name string, v interface{}) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if os.IsNotExist(err) {
+		t.Skipf("testdata/%v isn't recorded; see `just record-vectors`", name)
+	}
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, v))
+}
+
+func TestEncodingVectors(t *testing.T) {
+	req := require.New(t)
+
+	var vectors encodingVectors
+	readVectors(t, "encoding_vectors.json", &vectors)
+
+	for i, v := range vectors.AppTemplates {
+		encoded, err := EncodeAppTemplate(v.Version, v.Name, v.Code, v.DataLayout)
+		req.NoError(err)
+		req.Equal(v.Encoded, encoded, "app-template #%v", i)
+	}
+
+	for i, v := range vectors.SpawnApps {
+		var args Values
+		req.NoError(args.Decode(v.FuncArgs))
+
+		encoded, err := EncodeSpawnApp(v.Version, v.Addr, v.FuncIndex, v.FuncBuffer, args)
+		req.NoError(err)
+		req.Equal(v.Encoded, encoded, "spawn-app #%v", i)
+	}
+
+	for i, v := range vectors.AppTxs {
+		var args Values
+		req.NoError(args.Decode(v.FuncArgs))
+
+		encoded, err := EncodeAppTx(v.Version, v.Addr, v.FuncIndex, v.FuncBuffer, args)
+		req.NoError(err)
+		req.Equal(v.Encoded, encoded, "app-tx #%v", i)
+	}
+}
+
+func TestDecodingVectors(t *testing.T) {
+	req := require.New(t)
+
+	var vectors encodingVectors
+	readVectors(t, "encoding_vectors.json", &vectors)
+
+	for i, v := range vectors.AppTemplates {
+		appTemplate, err := DecodeAppTemplate(v.Encoded)
+		req.NoError(err, "app-template #%v", i)
+		req.Equal(v.Version, appTemplate.Version, "app-template #%v", i)
+		req.Equal(v.Name, appTemplate.Name, "app-template #%v", i)
+		req.Equal(append([]byte{}, v.Code...), appTemplate.Code, "app-template #%v", i)
+		req.Equal([]uint32(v.DataLayout), []uint32(appTemplate.DataLayout), "app-template #%v", i)
+	}
+
+	for i, v := range vectors.SpawnApps {
+		spawnApp, err := DecodeSpawnApp(v.Encoded)
+		req.NoError(err, "spawn-app #%v", i)
+		req.Equal(v.Version, spawnApp.Version, "spawn-app #%v", i)
+		req.Equal(v.Addr, spawnApp.TemplateAddr, "spawn-app #%v", i)
+		req.Equal(v.FuncIndex, spawnApp.CtorIndex, "spawn-app #%v", i)
+		req.Equal(append([]byte{}, v.FuncBuffer...), spawnApp.CtorBuffer, "spawn-app #%v", i)
+		req.Equal(v.FuncArgs, spawnApp.CtorArgs.Encode(), "spawn-app #%v", i)
+	}
+
+	for i, v := range vectors.AppTxs {
+		appTx, err := DecodeAppTx(v.Encoded)
+		req.NoError(err, "app-tx #%v", i)
+		req.Equal(v.Version, appTx.Version, "app-tx #%v", i)
+		req.Equal(v.Addr, appTx.AppAddr, "app-tx #%v", i)
+		req.Equal(v.FuncIndex, appTx.FuncIndex, "app-tx #%v", i)
+		req.Equal(append([]byte{}, v.FuncBuffer...), appTx.FuncBuffer, "app-tx #%v", i)
+		req.Equal(v.FuncArgs, appTx.FuncArgs.Encode(), "app-tx #%v", i)
+	}
+}
+
+func TestReceiptVectors(t *testing.T) {
+	req := require.New(t)
+
+	var vectors receiptVectors
+	readVectors(t, "receipt_vectors.json", &vectors)
+
+	for i, v := range vectors.Deploys {
+		r, err := parseDeployReceipt(v.Receipt)
+		req.NoError(err, "deploy receipt #%v", i)
+		req.Equal(v.Success, r.Success, "deploy receipt #%v", i)
+		if !v.Success {
+			continue
+		}
+		req.Equal(v.TemplateAddr, r.TemplateAddr, "deploy receipt #%v", i)
+		req.Equal(v.GasUsed, r.GasUsed, "deploy receipt #%v", i)
+	}
+
+	for i, v := range vectors.Spawns {
+		r, err := parseSpawnReceipt(v.Receipt)
+		req.NoError(err, "spawn receipt #%v", i)
+		req.Equal(v.Success, r.Success, "spawn receipt #%v", i)
+		if !v.Success {
+			continue
+		}
+		req.Equal(v.AppAddr, r.AppAddr, "spawn receipt #%v", i)
+		req.Equal(v.InitialState, r.InitialState, "spawn receipt #%v", i)
+		req.Equal(v.Returns, r.Returns.Encode(), "spawn receipt #%v", i)
+		req.Equal(v.GasUsed, r.GasUsed, "spawn receipt #%v", i)
+	}
+
+	for i, v := range vectors.Execs {
+		r, err := parseExecReceipt(v.Receipt)
+		req.NoError(err, "exec receipt #%v", i)
+		req.Equal(v.Success, r.Success, "exec receipt #%v", i)
+		if !v.Success {
+			continue
+		}
+		req.Equal(v.NewState, r.NewState, "exec receipt #%v", i)
+		req.Equal(v.Returns, r.Returns.Encode(), "exec receipt #%v", i)
+		req.Equal(v.GasUsed, r.GasUsed, "exec receipt #%v", i)
+	}
+}
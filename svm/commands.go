@@ -1,6 +1,10 @@
 package svm
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"unsafe"
+)
 
 type DeployTemplateResult struct {
 	Receipt      []byte
@@ -17,121 +21,333 @@ func (r DeployTemplateResult) String() string {
 		r.Receipt, r.TemplateAddr, r.GasUsed)
 }
 
+// SpawnAppResult is the result of a SpawnApp command.
+// When the spawn failed (Success is false), Err holds the failure reason
+// reported by the receipt, and the rest of the fields are not set.
+// In particular, the receipt of a failed spawn doesn't report the gas used,
+// so GasUsedKnown is false, and the fee to charge is left up to the caller.
 type SpawnAppResult struct {
 	Receipt      []byte
+	Success      bool
+	Err          error
 	InitialState []byte
 	AppAddr      Address
+	Returns      Values
 	GasUsed      uint64
+	GasUsedKnown bool
 }
 
 func (r SpawnAppResult) String() string {
 	return fmt.Sprintf(
 		"SpawnApp result:\n"+
 			"  Receipt: %x\n"+
+			"  Success: %v\n"+
+			"  Err: %v\n"+
 			"  InitialState: %x\n"+
 			"  AppAddr: %x\n"+
+			"  Returns: %v\n"+
 			"  GasUsed: %v\n",
-		r.Receipt, r.InitialState, r.AppAddr, r.GasUsed)
+		r.Receipt, r.Success, r.Err, r.InitialState, r.AppAddr, r.Returns, gasUsedString(r.GasUsed, r.GasUsedKnown))
 }
 
+// ExecAppResult is the result of an ExecApp command.
+// When the execution failed (Success is false), Err holds the failure reason
+// reported by the receipt, and the rest of the fields are not set,
+// with GasUsedKnown being false (see SpawnAppResult).
 type ExecAppResult struct {
-	Receipt  []byte
-	NewState []byte
-	Returns  Values
-	GasUsed  uint64
+	Receipt      []byte
+	Success      bool
+	Err          error
+	NewState     []byte
+	Returns      Values
+	GasUsed      uint64
+	GasUsedKnown bool
 }
 
 func (r ExecAppResult) String() string {
 	return fmt.Sprintf(
 		"ExecApp result:\n"+
 			"  Receipt: %x\n"+
+			"  Success: %v\n"+
+			"  Err: %v\n"+
 			"  NewState: %x\n"+
 			"  Returns: %v\n"+
 			"  GasUsed: %v\n",
-		r.Receipt, r.NewState, r.Returns, r.GasUsed)
+		r.Receipt, r.Success, r.Err, r.NewState, r.Returns, gasUsedString(r.GasUsed, r.GasUsedKnown))
 }
 
-func DeployTemplate(runtime Runtime, appTemplate []byte, author Address, hostCtx []byte, gasMetering bool, gasLimit uint64) (*DeployTemplateResult, error) {
-	receipt, err := cSvmDeployTemplate(runtime, appTemplate, author, hostCtx, gasMetering, gasLimit)
-	if err != nil {
-		return nil, err
-	}
+// QueryAppResult is the result of a QueryApp command.
+// When the execution failed (Success is false), Err holds the failure reason
+// reported by the receipt, and the rest of the fields are not set,
+// with GasUsedKnown being false (see SpawnAppResult).
+type QueryAppResult struct {
+	Receipt      []byte
+	Success      bool
+	Err          error
+	Returns      Values
+	GasUsed      uint64
+	GasUsedKnown bool
+}
+
+func (r QueryAppResult) String() string {
+	return fmt.Sprintf(
+		"QueryApp result:\n"+
+			"  Receipt: %x\n"+
+			"  Success: %v\n"+
+			"  Err: %v\n"+
+			"  Returns: %v\n"+
+			"  GasUsed: %v\n",
+		r.Receipt, r.Success, r.Err, r.Returns, gasUsedString(r.GasUsed, r.GasUsedKnown))
+}
+
+// Deploy deploys the raw app-template, with the given options, over the runtime default
+// options (see ExecOptions). See DeployTemplate and DeployTemplateContext for the details.
+func (r Runtime) Deploy(ctx context.Context, appTemplate []byte, author Address, opts *ExecOptions) (*DeployTemplateResult, error) {
+	opts = r.options(opts)
 
-	addr, err := cSvmTemplateReceiptAddr(receipt)
+	p, err := r.enterContext(ctx, OpDeploy)
 	if err != nil {
 		return nil, err
 	}
+	defer r.exit()
 
-	gasUsed, err := cSvmTemplateReceiptGas(receipt)
+	receipt, err := cSvmDeployTemplate(p, appTemplate, author, opts.encodedHostCtx(), opts.gasMetering(), opts.gasLimit())
 	if err != nil {
 		return nil, err
 	}
 
-	return &DeployTemplateResult{
-		Receipt:      receipt,
-		TemplateAddr: addr,
-		GasUsed:      gasUsed,
-	}, nil
+	return readDeployReceipt(receipt)
 }
 
-func SpawnApp(runtime Runtime, spawnAppData []byte, creator Address, hostCtx []byte,
-	gasMetering bool, gasLimit uint64) (*SpawnAppResult, error) {
+// Spawn spawns a new app out of the raw spawn-app, with the given options, over the runtime default
+// options (see ExecOptions). See SpawnApp and SpawnAppContext for the details.
+func (r Runtime) Spawn(ctx context.Context, spawnAppData []byte, creator Address, opts *ExecOptions) (*SpawnAppResult, error) {
+	opts = r.options(opts)
 
-	receipt, err := cSvmSpawnApp(runtime, spawnAppData, creator, hostCtx, gasMetering, gasLimit)
+	p, err := r.enterContext(ctx, OpSpawn)
 	if err != nil {
 		return nil, err
 	}
+	defer r.exit()
 
-	initialState, err := cSvmAppReceiptState(receipt)
+	receipt, err := cSvmSpawnApp(p, spawnAppData, creator, opts.encodedHostCtx(), opts.gasMetering(), opts.gasLimit())
+	if panicErr := r.takeImportPanic(); panicErr != nil {
+		return nil, panicErr
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	addr, err := cSvmAppReceiptAddr(receipt)
+	return readSpawnReceipt(receipt)
+}
+
+// Exec executes the raw app-tx against the given app state, with the given options, over the runtime
+// default options (see ExecOptions). See ExecApp and ExecAppContext for the details.
+func (r Runtime) Exec(ctx context.Context, appTx, appState []byte, opts *ExecOptions) (*ExecAppResult, error) {
+	opts = r.options(opts)
+
+	p, err := r.enterContext(ctx, OpExec)
 	if err != nil {
 		return nil, err
 	}
+	defer r.exit()
 
-	gasUsed, err := cSvmAppReceiptGas(receipt)
+	receipt, err := cSvmExecApp(OpExec, p, appTx, appState, opts.encodedHostCtx(), opts.gasMetering(), opts.gasLimit())
+	if panicErr := r.takeImportPanic(); panicErr != nil {
+		return nil, panicErr
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &SpawnAppResult{
-		Receipt:      receipt,
-		InitialState: initialState,
-		AppAddr:      addr,
-		GasUsed:      gasUsed,
-	}, nil
+	return readExecReceipt(OpExec, receipt)
 }
 
-func ExecApp(runtime Runtime, appTx, appState, hostCtx []byte, gasMetering bool,
-	gasLimit uint64) (*ExecAppResult, error) {
+// Query executes the raw app-tx against the given app state, like Exec, but discards
+// its storage changes, with the given options, over the runtime default options (see ExecOptions).
+// See QueryApp for the details.
+func (r Runtime) Query(ctx context.Context, appTx, appState []byte, opts *ExecOptions) (*QueryAppResult, error) {
+	if r.memKV == nil {
+		return nil, newError(OpQuery, ErrValidation, "the runtime isn't backed by a memory kv-store")
+	}
 
-	receipt, err := cSvmExecApp(runtime, appTx, appState, hostCtx, gasMetering, gasLimit)
-	if err != nil {
+	opts = r.options(opts)
+
+	if _, err := r.enterContext(ctx, OpQuery); err != nil {
 		return nil, err
 	}
+	defer r.exit()
 
-	newState, err := cSvmExecReceiptState(receipt)
-	if err != nil {
+	// The query runs on a runtime of its own, whose storage goes through an overlay over
+	// the runtime kv-store. The overlay keeps the storage writes until it's destroyed,
+	// so they never reach the kv-store. Since the calls into the runtimes sharing
+	// the kv-store are serialized, it isn't used by anything else meanwhile.
+	var overlay unsafe.Pointer
+	if err := cSvmDepOverlayKVCreate(&overlay, r.memKV); err != nil {
+		return nil, err
+	}
+	defer cSvmDepOverlayKVDestroy(overlay)
+
+	var p unsafe.Pointer
+	if err := cSvmMemoryRuntimeCreate(&p, cSvmDepOverlayKVStore(overlay), r.host, r.imports); err != nil {
 		return nil, err
 	}
+	defer cSvmRuntimeDestroy(p)
 
-	returns, err := cSvmExecReceiptReturns(receipt)
+	receipt, err := cSvmExecApp(OpQuery, p, appTx, appState, opts.encodedHostCtx(), opts.gasMetering(), opts.gasLimit())
+	if panicErr := r.takeImportPanic(); panicErr != nil {
+		return nil, panicErr
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	gasUsed, err := cSvmExecReceiptGas(receipt)
+	res, err := readExecReceipt(OpQuery, receipt)
 	if err != nil {
 		return nil, err
 	}
 
-	return &ExecAppResult{
-		Receipt:  receipt,
-		NewState: newState,
-		Returns:  returns,
-		GasUsed:  gasUsed,
+	return &QueryAppResult{
+		Receipt:      res.Receipt,
+		Success:      res.Success,
+		Err:          res.Err,
+		Returns:      res.Returns,
+		GasUsed:      res.GasUsed,
+		GasUsedKnown: res.GasUsedKnown,
 	}, nil
 }
+
+// gasUsedString formats the gas used reported by a result, which may be unknown.
+func gasUsedString(gasUsed uint64, known bool) string {
+	if !known {
+		return "unknown"
+	}
+
+	return fmt.Sprint(gasUsed)
+}
+
+func DeployTemplate(runtime Runtime, appTemplate []byte, author Address, hostCtx []byte, gasMetering bool, gasLimit uint64) (*DeployTemplateResult, error) {
+	return DeployTemplateContext(context.Background(), runtime, appTemplate, author, hostCtx, gasMetering, gasLimit)
+}
+
+// DeployTemplateContext is like DeployTemplate, but it fails without entering the runtime
+// once ctx is done, including while waiting for the runtime calls entered before it.
+// Once entered, the transaction can't be interrupted, and it runs to completion.
+// Import functions called during it can get ctx via RequestContext.
+func DeployTemplateContext(ctx context.Context, runtime Runtime, appTemplate []byte, author Address, hostCtx []byte,
+	gasMetering bool, gasLimit uint64) (*DeployTemplateResult, error) {
+
+	return runtime.Deploy(ctx, appTemplate, author, adapterExecOptions(hostCtx, &GasOptions{Metering: gasMetering, Limit: gasLimit}))
+}
+
+// EstimateDeployTemplate returns the estimated gas required for deploying the raw app-template.
+// Since the runtime panics when given malformed input, the app-template is validated first,
+// and a validation failure is returned as an error.
+func EstimateDeployTemplate(runtime Runtime, appTemplate []byte) (uint64, error) {
+	if err := ValidateTemplate(runtime, appTemplate); err != nil {
+		return 0, err
+	}
+
+	p, err := runtime.enter(OpEstimate)
+	if err != nil {
+		return 0, err
+	}
+	defer runtime.exit()
+
+	return cSvmEstimateDeployTemplate(p, appTemplate)
+}
+
+// SpawnApp spawns a new app out of the raw spawn-app.
+// A returned error means that the runtime failed to process the transaction,
+// and no receipt was produced. A failed transaction which did produce a receipt
+// (e.g. a failing constructor) is reported via the result Success and Err fields instead.
+// A panic raised by an import function is returned as an *ImportPanicError,
+// and the transaction receipt is dropped (see ImportPanicError).
+// A nil hostCtx means an empty host ctx, whatever the runtime default one (see RuntimeBuilder.WithExecOptions).
+func SpawnApp(runtime Runtime, spawnAppData []byte, creator Address, hostCtx []byte,
+	gasMetering bool, gasLimit uint64) (*SpawnAppResult, error) {
+
+	return SpawnAppContext(context.Background(), runtime, spawnAppData, creator, hostCtx, gasMetering, gasLimit)
+}
+
+// SpawnAppContext is like SpawnApp, but it fails without entering the runtime
+// once ctx is done, including while waiting for the runtime calls entered before it.
+// Once entered, the transaction can't be interrupted, and it runs to completion.
+// Import functions called during it can get ctx via RequestContext.
+func SpawnAppContext(ctx context.Context, runtime Runtime, spawnAppData []byte, creator Address, hostCtx []byte,
+	gasMetering bool, gasLimit uint64) (*SpawnAppResult, error) {
+
+	return runtime.Spawn(ctx, spawnAppData, creator, adapterExecOptions(hostCtx, &GasOptions{Metering: gasMetering, Limit: gasLimit}))
+}
+
+// EstimateSpawnApp returns the estimated gas required for spawning the raw spawn-app.
+// Since the runtime panics when given malformed input, the spawn-app is validated first,
+// and a validation failure is returned as an error.
+func EstimateSpawnApp(runtime Runtime, spawnAppData []byte) (uint64, error) {
+	if err := ValidateApp(runtime, spawnAppData); err != nil {
+		return 0, err
+	}
+
+	p, err := runtime.enter(OpEstimate)
+	if err != nil {
+		return 0, err
+	}
+	defer runtime.exit()
+
+	return cSvmEstimateSpawnApp(p, spawnAppData)
+}
+
+// ExecApp executes the raw app-tx against the given app state.
+// A returned error means that the runtime failed to process the transaction,
+// and no receipt was produced. A failed transaction which did produce a receipt
+// (e.g. a trap, running out of gas or calling a non-existing function)
+// is reported via the result Success and Err fields instead, Err matching ErrTxFailed.
+// A panic raised by an import function is returned as an *ImportPanicError,
+// and the transaction receipt is dropped (see ImportPanicError).
+// A nil hostCtx means an empty host ctx, whatever the runtime default one (see RuntimeBuilder.WithExecOptions).
+func ExecApp(runtime Runtime, appTx, appState, hostCtx []byte, gasMetering bool,
+	gasLimit uint64) (*ExecAppResult, error) {
+
+	return ExecAppContext(context.Background(), runtime, appTx, appState, hostCtx, gasMetering, gasLimit)
+}
+
+// ExecAppContext is like ExecApp, but it fails without entering the runtime
+// once ctx is done, including while waiting for the runtime calls entered before it.
+// Once entered, the transaction can't be interrupted, and it runs to completion.
+// Import functions called during it can get ctx via RequestContext.
+func ExecAppContext(ctx context.Context, runtime Runtime, appTx, appState, hostCtx []byte, gasMetering bool,
+	gasLimit uint64) (*ExecAppResult, error) {
+
+	return runtime.Exec(ctx, appTx, appState, adapterExecOptions(hostCtx, &GasOptions{Metering: gasMetering, Limit: gasLimit}))
+}
+
+// EstimateExecApp returns the estimated gas required for executing the raw app-tx.
+// Since the runtime panics when given malformed input, the app-tx is validated first,
+// and a validation failure is returned as an error.
+func EstimateExecApp(runtime Runtime, appTx []byte) (uint64, error) {
+	if _, err := ValidateAppTx(runtime, appTx); err != nil {
+		return 0, err
+	}
+
+	p, err := runtime.enter(OpEstimate)
+	if err != nil {
+		return 0, err
+	}
+	defer runtime.exit()
+
+	return cSvmEstimateExecApp(p, appTx)
+}
+
+// QueryApp executes the raw app-tx against the given app state, for reading it, as view functions
+// (e.g. a getter) do. Unlike ExecApp, nothing is committed to the runtime kv-store,
+// whatever the function does: its storage writes are kept in an overlay, which is discarded
+// once it returns. So the result has no new state. The runtime must be backed by a MemKVStore:
+// the runtimes backed by a disk kv-store aren't supported, and fail with ErrValidation, since
+// the SVM C API keeps the disk kv-store it opens to itself, and it can't be opened again,
+// underneath an overlay, while the runtime uses it.
+// The overlay is provided by the `svm-dep` shim, so it requires the `svmdep` build tag (see ErrUnsupported).
+// The gas metering and limit are the runtime default ones (see RuntimeBuilder.WithExecOptions),
+// but a nil hostCtx means an empty host ctx, like with ExecApp. The failures are reported like ExecApp does.
+func QueryApp(runtime Runtime, appTx, appState, hostCtx []byte) (*QueryAppResult, error) {
+	return runtime.Query(context.Background(), appTx, appState, adapterExecOptions(hostCtx, nil))
+}
@@ -0,0 +1,238 @@
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+)
+
+func newTestRuntime(req *require.Assertions) (Runtime, func()) {
+	imports := newTestImports(req)
+
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithMemKVStore(kv).
+		Build()
+	req.NoError(err)
+
+	return runtime, func() {
+		runtime.Free()
+		kv.Free()
+		imports.Free()
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	req.NoError(err)
+	appTemplate, err := EncodeAppTemplate(0, "storage", code, DataLayout{4})
+	req.NoError(err)
+
+	estimation, err := EstimateDeployTemplate(runtime, appTemplate)
+	req.NoError(err)
+	req.NotZero(estimation)
+
+	templateAddr := deployTestTemplate(req, runtime)
+	spawnApp, err := EncodeSpawnApp(0, templateAddr, storageIncFuncIndex, nil, Values{I32(5)})
+	req.NoError(err)
+
+	estimation, err = EstimateSpawnApp(runtime, spawnApp)
+	req.NoError(err)
+	req.NotZero(estimation)
+
+	spawnAppResult := spawnTestApp(req, runtime, templateAddr, 5)
+	appTx, err := EncodeAppTx(0, spawnAppResult.AppAddr, storageIncFuncIndex, nil, Values{I32(5)})
+	req.NoError(err)
+
+	estimation, err = EstimateExecApp(runtime, appTx)
+	req.NoError(err)
+	req.NotZero(estimation)
+}
+
+func TestEstimate_MalformedInput(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	malformed := []byte{0xFF, 0xFF, 0xFF}
+
+	_, err := EstimateDeployTemplate(runtime, malformed)
+	req.Error(err)
+
+	_, err = EstimateSpawnApp(runtime, malformed)
+	req.Error(err)
+
+	_, err = EstimateExecApp(runtime, malformed)
+	req.Error(err)
+}
+
+func TestExecApp_FailedReceipt(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	templateAddr := deployTestTemplate(req, runtime)
+	spawnAppResult := spawnTestApp(req, runtime, templateAddr, 5)
+
+	// Call a non-existing function.
+	appTx, err := EncodeAppTx(0, spawnAppResult.AppAddr, 100, nil, nil)
+	req.NoError(err)
+
+	res, err := ExecApp(runtime, appTx, spawnAppResult.InitialState, emptyHostCtx(), false, 0)
+	req.NoError(err)
+	req.False(res.Success)
+	req.True(errors.Is(res.Err, ErrTxFailed), "unexpected error: %v", res.Err)
+	req.NotEmpty(res.Receipt)
+	req.Nil(res.NewState)
+
+	// The receipt of a failed transaction doesn't report its gas used, even with gas metering.
+	res, err = ExecApp(runtime, appTx, spawnAppResult.InitialState, emptyHostCtx(), true, 1000000)
+	req.NoError(err)
+	req.False(res.Success)
+	req.False(res.GasUsedKnown)
+	req.Equal(uint64(0), res.GasUsed)
+}
+
+func TestExecApp_BridgeError(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	res, err := ExecApp(runtime, []byte{0xFF, 0xFF, 0xFF}, nil, emptyHostCtx(), false, 0)
+	req.Error(err)
+	req.Nil(res)
+
+	var svmErr *Error
+	req.True(errors.As(err, &svmErr))
+	req.Equal(OpExec, svmErr.Op)
+	req.True(errors.Is(err, ErrRuntime))
+}
+
+func TestSpawnApp_Returns(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	templateAddr := deployTestTemplate(req, runtime)
+
+	// `storage_inc` constructor returns nothing.
+	spawnAppResult := spawnTestApp(req, runtime, templateAddr, 5)
+	req.Equal(Values{}, spawnAppResult.Returns)
+
+	// `storage_get` constructor returns the (zero-initialized) var #0.
+	spawnApp, err := EncodeSpawnApp(0, templateAddr, storageGetFuncIndex, nil, nil)
+	req.NoError(err)
+
+	spawnAppResult, err = SpawnApp(runtime, spawnApp, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+	req.True(spawnAppResult.Success, "spawn-app failed: %v", spawnAppResult.Err)
+	req.Equal(Values{I32(0)}, spawnAppResult.Returns)
+}
+
+func TestQueryApp(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	templateAddr := deployTestTemplate(req, runtime)
+	spawnAppResult := spawnTestApp(req, runtime, templateAddr, 5)
+	appAddr, state := spawnAppResult.AppAddr, spawnAppResult.InitialState
+
+	incTx, err := EncodeAppTx(0, appAddr, storageIncFuncIndex, nil, Values{I32(3)})
+	req.NoError(err)
+	getTx, err := EncodeAppTx(0, appAddr, storageGetFuncIndex, nil, nil)
+	req.NoError(err)
+
+	// A query of a function which does write into the storage leaves the kv-store head as is.
+	head := cSvmDepMemoryKVHead(runtime.memKV)
+	res, err := QueryApp(runtime, incTx, state, nil)
+	if errors.Is(err, ErrUnsupported) {
+		t.Skip("QueryApp requires the svm-dep shim; build with the `svmdep` build tag")
+	}
+	req.NoError(err)
+	req.True(res.Success, "query failed: %v", res.Err)
+	req.Equal(head, cSvmDepMemoryKVHead(runtime.memKV))
+
+	res, err = QueryApp(runtime, getTx, state, nil)
+	req.NoError(err)
+	req.Equal(Values{I32(5)}, res.Returns)
+	req.Equal(head, cSvmDepMemoryKVHead(runtime.memKV))
+
+	// Whereas executing the same transaction does commit its new state.
+	execRes, err := ExecApp(runtime, incTx, state, nil, false, 0)
+	req.NoError(err)
+	req.True(execRes.Success, "exec-app failed: %v", execRes.Err)
+	req.NotEqual(head, cSvmDepMemoryKVHead(runtime.memKV))
+
+	res, err = QueryApp(runtime, getTx, execRes.NewState, nil)
+	req.NoError(err)
+	req.Equal(Values{I32(8)}, res.Returns)
+
+	appTx, err := EncodeAppTx(0, appAddr, 100, nil, nil)
+	req.NoError(err)
+	res, err = QueryApp(runtime, appTx, state, nil)
+	req.NoError(err)
+	req.False(res.Success)
+	req.True(errors.Is(res.Err, ErrTxFailed), "unexpected error: %v", res.Err)
+
+	var svmErr *Error
+	req.True(errors.As(res.Err, &svmErr))
+	req.Equal(OpQuery, svmErr.Op)
+}
+
+func TestQueryApp_BridgeError(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	res, err := QueryApp(runtime, []byte{0xFF, 0xFF, 0xFF}, nil, nil)
+	if errors.Is(err, ErrUnsupported) {
+		t.Skip("QueryApp requires the svm-dep shim; build with the `svmdep` build tag")
+	}
+	req.Error(err)
+	req.Nil(res)
+
+	var svmErr *Error
+	req.True(errors.As(err, &svmErr))
+	req.Equal(OpQuery, svmErr.Op)
+	req.True(errors.Is(err, ErrRuntime))
+}
+
+func TestQueryApp_NoMemKV(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+	defer runtime.Free()
+
+	_, err := QueryApp(runtime, nil, nil, nil)
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+	req.EqualError(err, "svm error: query: validation failed: the runtime isn't backed by a memory kv-store")
+
+	// A disk kv-store can't be queried.
+	runtime.diskKVPath = "kv"
+	_, err = QueryApp(runtime, nil, nil, nil)
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+}
+
+func TestExecAppResult_String_GasUsedUnknown(t *testing.T) {
+	req := require.New(t)
+
+	req.Contains(ExecAppResult{GasUsed: 10, GasUsedKnown: true}.String(), "  GasUsed: 10\n")
+	req.Contains(ExecAppResult{}.String(), "  GasUsed: unknown\n")
+}
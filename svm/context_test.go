@@ -0,0 +1,120 @@
+package svm
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+type testRequestKey struct{}
+
+func TestRuntime_EnterContext(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := runtime.enterContext(ctx, OpExec)
+	req.EqualError(err, "svm error: exec: canceled: context canceled")
+	req.True(errors.Is(err, ErrCanceled))
+	req.True(errors.Is(err, context.Canceled))
+
+	// The command fails before crossing into SVM.
+	_, err = ExecAppContext(ctx, runtime, nil, nil, nil, false, 0)
+	req.True(errors.Is(err, context.Canceled))
+
+	// Waiting for the runtime respects the deadline.
+	_, err = runtime.enter(OpExec)
+	req.NoError(err)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = runtime.enterContext(ctx, OpSpawn)
+	req.EqualError(err, "svm error: spawn: canceled: context deadline exceeded")
+	req.True(errors.Is(err, ErrCanceled))
+	req.True(errors.Is(err, context.DeadlineExceeded))
+
+	runtime.exit()
+	runtime.Free()
+	req.Equal(int32(1), destroyed)
+}
+
+func TestRuntime_RequestContext(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+	runtime.host = newHostHandle(&hostEntry{})
+	defer releaseHostHandle(runtime.host)
+	defer runtime.Free()
+
+	host, ok := lookupHostHandle(runtime.host)
+	req.True(ok)
+	req.Equal(context.Background(), host.requestContext())
+
+	ctx := context.WithValue(context.Background(), testRequestKey{}, "request #1")
+	_, err := runtime.enterContext(ctx, OpExec)
+	req.NoError(err)
+	req.Equal("request #1", host.requestContext().Value(testRequestKey{}))
+
+	runtime.exit()
+	req.Equal(context.Background(), host.requestContext())
+}
+
+func TestExecAppContext_RequestValues(t *testing.T) {
+	req := require.New(t)
+
+	var got []interface{}
+	ib := NewImportsBuilder()
+	ib, err := ib.AppendFunction("inc", func(ctx unsafe.Pointer, value int32) {
+		got = append(got, RequestContext(ctx).Value(testRequestKey{}))
+	})
+	req.NoError(err)
+	ib, err = ib.AppendFunction("get", func(ctx unsafe.Pointer) int32 { return 0 })
+	req.NoError(err)
+	imports, err := ib.Build()
+	req.NoError(err)
+	defer imports.Free()
+
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+	defer kv.Free()
+
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithMemKVStore(kv).
+		Build()
+	req.NoError(err)
+	defer runtime.Free()
+
+	code, err := ioutil.ReadFile("testdata/counter_template.wasm")
+	req.NoError(err)
+	appTemplate, err := EncodeAppTemplate(0, "counter", code, DataLayout{4})
+	req.NoError(err)
+	ctx := context.WithValue(context.Background(), testRequestKey{}, "request #1")
+	deployTemplateResult, err := DeployTemplateContext(ctx, runtime, appTemplate, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+
+	spawnApp, err := EncodeSpawnApp(0, deployTemplateResult.TemplateAddr, 0, nil, Values{I32(0)})
+	req.NoError(err)
+	spawnAppResult, err := SpawnAppContext(ctx, runtime, spawnApp, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+	req.True(spawnAppResult.Success, "spawn-app failed: %v", spawnAppResult.Err)
+
+	appTx, err := EncodeAppTx(0, spawnAppResult.AppAddr, 2, nil, Values{I32(1)})
+	req.NoError(err)
+	execAppResult, err := ExecAppContext(ctx, runtime, appTx, spawnAppResult.InitialState, emptyHostCtx(), false, 0)
+	req.NoError(err)
+	req.True(execAppResult.Success, "exec-app failed: %v", execAppResult.Err)
+	req.Equal([]interface{}{"request #1"}, got)
+
+	// Without a request context, import functions get the background context.
+	execTestApp(req, runtime, spawnAppResult.AppAddr, 2, Values{I32(1)}, execAppResult.NewState)
+	req.Equal([]interface{}{"request #1", nil}, got)
+}
@@ -1,19 +1,32 @@
 package svm
 
-import (
-	"encoding/binary"
-)
+import "go-svm/svm/codec"
+
+type DataLayout = codec.DataLayout
+
+type DataLayoutBuilder = codec.DataLayoutBuilder
+
+type Var = codec.Var
 
-type DataLayout []uint32
+type Vars = codec.Vars
 
-func (dl DataLayout) Encode() []byte {
-	buf := make([]byte, len(dl)*4)
-	offset := 0
+type VarType = codec.VarType
 
-	for _, v := range dl {
-		binary.BigEndian.PutUint32(buf[offset:], v)
-		offset += 4
-	}
+const (
+	VarU32     = codec.VarU32
+	VarU64     = codec.VarU64
+	VarBool    = codec.VarBool
+	VarAddress = codec.VarAddress
+	VarBytes   = codec.VarBytes
+)
+
+// NewDataLayoutBuilder returns an empty DataLayoutBuilder.
+func NewDataLayoutBuilder() DataLayoutBuilder {
+	return codec.NewDataLayoutBuilder()
+}
 
-	return buf
+// DecodeDataLayout decodes a data layout according to the encoding format
+// defined in DataLayout.Encode.
+func DecodeDataLayout(data []byte) (DataLayout, error) {
+	return codec.DecodeDataLayout(data)
 }
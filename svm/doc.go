@@ -65,6 +65,24 @@
 // Thanks to `bridge.go`, the rest of this package can talk to
 // SVM as if it is almost regular Go code.
 //
+// Threading model
+//
+// A Runtime may be shared across goroutines. Since the SVM runtime itself
+// isn't safe for concurrent use, the calls into a single Runtime (validating,
+// estimating, deploying, spawning and executing) are serialized by an internal
+// lock, so they run one at a time, in no particular order. The runtimes built
+// with the same MemKVStore share that lock, since the kv-store isn't safe for
+// concurrent use either. Hence, an import function must not call into the Runtime
+// that is running it, or into one sharing its MemKVStore, or it deadlocks.
+//
+// Thus several runtimes add no concurrency over a single one: the runtimes sharing
+// a MemKVStore run one call at a time, and a disk kv-store can't be opened by several
+// runtimes. Runtimes with distinct kv-stores do run concurrently, but they don't share
+// their templates, apps and app states.
+//
+// Imports and MemKVStore have no methods besides freeing them, which is safe
+// from any goroutine, as is freeing a Runtime.
+//
 // [SVM]: https://github.com/spacemeshos/svm
 // [Rust]: https://www.rust-lang.org/
 // [svm-runtime-c-api]: https://github.com/spacemeshos/svm/tree/master/crates/svm-runtime-c-api
@@ -1,9 +1,34 @@
 package svm
 
+import (
+	"go-svm/svm/codec"
+	"go-svm/svm/wat"
+)
+
+// AppTemplate is a decoded raw `app-template` transaction.
+type AppTemplate = codec.AppTemplate
+
+// SpawnAppTx is a decoded raw `spawn-app` transaction.
+type SpawnAppTx = codec.SpawnApp
+
+// AppTx is a decoded raw `app-tx` transaction.
+type AppTx = codec.AppTx
+
 func EncodeAppTemplate(version int, name string, code []byte, dataLayout DataLayout) ([]byte, error) {
 	return cSvmEncodeAppTemplate(version, name, code, dataLayout)
 }
 
+// EncodeAppTemplateFromWat is like EncodeAppTemplate, but the template code is given
+// in the WebAssembly text format, and is compiled via the wat package.
+func EncodeAppTemplateFromWat(version int, name string, src []byte, dataLayout DataLayout) ([]byte, error) {
+	code, err := wat.Compile(src)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeAppTemplate(version, name, code, dataLayout)
+}
+
 func EncodeSpawnApp(version int, templateAddr Address, ctorIndex uint16, ctorBuffer []byte, ctorArgs Values) ([]byte, error) {
 	return cSvmEncodeSpawnApp(version, templateAddr, ctorIndex, ctorBuffer, ctorArgs)
 }
@@ -11,3 +36,18 @@ func EncodeSpawnApp(version int, templateAddr Address, ctorIndex uint16, ctorBuf
 func EncodeAppTx(version int, appAddr Address, funcIndex uint16, funcBuffer []byte, funcArgs Values) ([]byte, error) {
 	return cSvmEncodeAppTx(version, appAddr, funcIndex, funcBuffer, funcArgs)
 }
+
+// DecodeAppTemplate decodes a raw `app-template` transaction, as encoded by EncodeAppTemplate.
+func DecodeAppTemplate(appTemplate []byte) (*AppTemplate, error) {
+	return codec.DecodeAppTemplate(appTemplate)
+}
+
+// DecodeSpawnApp decodes a raw `spawn-app` transaction, as encoded by EncodeSpawnApp.
+func DecodeSpawnApp(spawnApp []byte) (*SpawnAppTx, error) {
+	return codec.DecodeSpawnApp(spawnApp)
+}
+
+// DecodeAppTx decodes a raw `app-tx` transaction, as encoded by EncodeAppTx.
+func DecodeAppTx(appTx []byte) (*AppTx, error) {
+	return codec.DecodeAppTx(appTx)
+}
@@ -0,0 +1,123 @@
+package svm
+
+import (
+	"github.com/stretchr/testify/require"
+	"go-svm/svm/codec"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+)
+
+// The differential tests below verify that the pure-Go encoders of the `codec`
+// package produce the exact same output as the SVM runtime encoders.
+
+const differentialIterations = 1000
+
+func randBytes(r *rand.Rand, maxLen int) []byte {
+	b := make([]byte, r.Intn(maxLen+1))
+	r.Read(b)
+	return b
+}
+
+func randAddress(r *rand.Rand) Address {
+	var addr Address
+	r.Read(addr[:])
+	return addr
+}
+
+func randValues(r *rand.Rand) Values {
+	values := make(Values, r.Intn(10))
+	for i := range values {
+		if r.Intn(2) == 0 {
+			values[i] = I32(r.Int31() - r.Int31())
+		} else {
+			values[i] = I64(r.Int63() - r.Int63())
+		}
+	}
+	return values
+}
+
+func TestEncodeAppTemplateFromWat(t *testing.T) {
+	req := require.New(t)
+
+	src, err := ioutil.ReadFile("testdata/counter_template.wat")
+	req.NoError(err)
+	code, err := ioutil.ReadFile("testdata/counter_template.wasm")
+	req.NoError(err)
+
+	expected, err := EncodeAppTemplate(0, "counter", code, DataLayout{4})
+	req.NoError(err)
+
+	actual, err := EncodeAppTemplateFromWat(0, "counter", src, DataLayout{4})
+	req.NoError(err)
+	req.Equal(expected, actual)
+}
+
+func TestEncodeAppTemplateFromWat_InvalidWat(t *testing.T) {
+	req := require.New(t)
+
+	_, err := EncodeAppTemplateFromWat(0, "counter", []byte("(module (func (call $missing)))"), DataLayout{4})
+	req.EqualError(err, "failed to compile wat: 1:21: unknown function $missing")
+}
+
+func TestEncodeAppTemplate_Differential(t *testing.T) {
+	req := require.New(t)
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < differentialIterations; i++ {
+		version := r.Intn(3)
+		name := string(randBytes(r, 64))
+		code := randBytes(r, 1024)
+		dataLayout := make(DataLayout, r.Intn(10))
+		for j := range dataLayout {
+			dataLayout[j] = r.Uint32()
+		}
+
+		expected, err := EncodeAppTemplate(version, name, code, dataLayout)
+		req.NoError(err)
+
+		actual, err := codec.EncodeAppTemplate(version, name, code, dataLayout)
+		req.NoError(err)
+		req.Equal(expected, actual, "iteration #%v", i)
+	}
+}
+
+func TestEncodeSpawnApp_Differential(t *testing.T) {
+	req := require.New(t)
+	r := rand.New(rand.NewSource(2))
+
+	for i := 0; i < differentialIterations; i++ {
+		version := r.Intn(3)
+		templateAddr := randAddress(r)
+		ctorIndex := uint16(r.Intn(1 << 16))
+		ctorBuffer := randBytes(r, 256)
+		ctorArgs := randValues(r)
+
+		expected, err := EncodeSpawnApp(version, templateAddr, ctorIndex, ctorBuffer, ctorArgs)
+		req.NoError(err)
+
+		actual, err := codec.EncodeSpawnApp(version, templateAddr, ctorIndex, ctorBuffer, ctorArgs)
+		req.NoError(err)
+		req.Equal(expected, actual, "iteration #%v", i)
+	}
+}
+
+func TestEncodeAppTx_Differential(t *testing.T) {
+	req := require.New(t)
+	r := rand.New(rand.NewSource(3))
+
+	for i := 0; i < differentialIterations; i++ {
+		version := r.Intn(3)
+		appAddr := randAddress(r)
+		funcIndex := uint16(r.Intn(1 << 16))
+		funcBuffer := randBytes(r, 256)
+		funcArgs := randValues(r)
+
+		expected, err := EncodeAppTx(version, appAddr, funcIndex, funcBuffer, funcArgs)
+		req.NoError(err)
+
+		actual, err := codec.EncodeAppTx(version, appAddr, funcIndex, funcBuffer, funcArgs)
+		req.NoError(err)
+		req.Equal(expected, actual, "iteration #%v", i)
+	}
+}
@@ -1,18 +1,142 @@
 package svm
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
-// svmError is error type which represent an error originated in the SVM runtime.
-type svmError struct {
-	s string
+// Op identifies the operation an Error originated from.
+type Op string
+
+const (
+	OpBuildImports  Op = "build imports"
+	OpCreateKV      Op = "create kv-store"
+	OpCreateRuntime Op = "create runtime"
+	OpEncode        Op = "encode"
+	OpValidate      Op = "validate"
+	OpEstimate      Op = "estimate"
+	OpDeploy        Op = "deploy"
+	OpSpawn         Op = "spawn"
+	OpExec          Op = "exec"
+	OpQuery         Op = "query"
+	OpReadStorage   Op = "read storage"
+	OpWriteStorage  Op = "write storage"
+)
+
+// The kinds of an Error. They can be matched via `errors.Is`, for example:
+//
+//	if errors.Is(err, svm.ErrValidation) { ... }
+var (
+	// ErrRuntime is a runtime failure which has no more specific kind.
+	ErrRuntime = errors.New("runtime failure")
+
+	// ErrAllocation is a failure to allocate a runtime resource.
+	ErrAllocation = errors.New("allocation failed")
+
+	// ErrEncoding is a failure to encode a raw transaction.
+	ErrEncoding = errors.New("encoding failed")
+
+	// ErrValidation is a failure to validate a raw transaction.
+	ErrValidation = errors.New("validation failed")
+
+	// ErrFreed is a usage of a freed Runtime, Imports or MemKVStore.
+	ErrFreed = errors.New("use of a freed handle")
+
+	// ErrInvalidReceipt is a failure to decode a receipt.
+	ErrInvalidReceipt = errors.New("invalid receipt")
+
+	// ErrUnsupported is a call which requires the `svm-dep` shim, in a build without
+	// the `svmdep` build tag (see `bridge_dep.go`).
+	ErrUnsupported = errors.New("unsupported")
+
+	// ErrCanceled is a call whose context was done before it entered the runtime.
+	// Its Error matches the context error as well, e.g. context.Canceled.
+	ErrCanceled = errors.New("canceled")
+
+	// ErrTxFailed is a failed transaction, as reported by its receipt.
+	// Its Error Msg holds the failure reason reported by the runtime.
+	ErrTxFailed = errors.New("transaction failed")
+
+	// ErrFuncNotFound is a call of a function which isn't in the template ABI.
+	ErrFuncNotFound = errors.New("function not found")
+
+	// ErrTrap is a transaction aborted by a panicking import function (see ImportPanicError).
+	ErrTrap = errors.New("trap")
+)
+
+// Error is error type which represent an error originated in the SVM runtime,
+// or a failed transaction, as reported by its receipt.
+// It unwraps to its Kind, so it can be matched via `errors.Is`.
+type Error struct {
+	// The operation the error originated from.
+	Op Op
+
+	// The error kind; one of the Err* values.
+	Kind error
+
+	// The raw SVM error message, if any.
+	Msg string
+
+	// The underlying error, if any, which the Error matches via `errors.Is` as well.
+	Err error
+}
+
+// newError creates a new Error instance.
+func newError(op Op, kind error, msg string) error {
+	return &Error{Op: op, Kind: kind, Msg: msg}
+}
+
+// wrapError creates a new Error instance out of an underlying error.
+func wrapError(op Op, kind error, err error) error {
+	return &Error{Op: op, Kind: kind, Msg: err.Error(), Err: err}
+}
+
+// Error helps Error to implement the error interface.
+func (e *Error) Error() string {
+	if e.Msg == "" {
+		return fmt.Sprintf("svm error: %v: %v", e.Op, e.Kind)
+	}
+
+	return fmt.Sprintf("svm error: %v: %v: %v", e.Op, e.Kind, e.Msg)
+}
+
+// Unwrap returns the error kind.
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+// Is reports whether the underlying error matches target, so the Error matches
+// both its kind and its underlying error via `errors.Is`.
+func (e *Error) Is(target error) bool {
+	return e.Err != nil && errors.Is(e.Err, target)
+}
+
+// ImportPanicError is error type which represent a panic raised by an import function.
+// The panic is recovered, so it doesn't unwind through the runtime, and the runtime is trapped
+// instead, which aborts the transaction like a trapping wasm instruction does, so its storage
+// changes are never committed. The transaction fails with this error instead of producing a result.
+// It matches ErrTrap via `errors.Is`.
+//
+// Trapping the runtime requires the `svm-dep` shim, since the SVM C API offers no way to trap
+// from an import function. Without the `svmdep` build tag, the panic isn't recovered, and
+// it crashes the process, like a panic raised by an AppendCgoFunction implementation.
+type ImportPanicError struct {
+	// The namespace-qualified name of the import function, something like `env.inc`.
+	Import string
+
+	// The value passed to panic.
+	Value interface{}
+
+	// The stack trace of the panicking goroutine.
+	Stack []byte
 }
 
-// newSvmError creates a new svmError instance from []byte slice.
-func newSvmError(b []byte) error {
-	return &svmError{s: string(b)}
+// Error helps ImportPanicError to implement the error interface.
+func (e *ImportPanicError) Error() string {
+	return fmt.Sprintf("import function `%v` panicked: %v", e.Import, e.Value)
 }
 
-// Error helps svmError to implement the error interface.
-func (e *svmError) Error() string {
-	return fmt.Sprintf("svm error: %v", e.s)
+// Is reports whether the target is ErrTrap.
+func (e *ImportPanicError) Is(target error) bool {
+	return target == ErrTrap
 }
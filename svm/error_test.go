@@ -1,6 +1,7 @@
 package svm
 
 import (
+	"errors"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -9,7 +10,30 @@ func TestSvmError(t *testing.T) {
 	req := require.New(t)
 
 	err := bytesCloneToSvmByteArray([]byte("Mayday"))
-	goError := err.svmError()
+	goError := err.svmError(OpValidate, ErrValidation)
 
-	req.Equal("svm error: Mayday", goError.Error())
+	req.Equal("svm error: validate: validation failed: Mayday", goError.Error())
+	req.True(errors.Is(goError, ErrValidation))
+	req.False(errors.Is(goError, ErrRuntime))
+
+	var svmErr *Error
+	req.True(errors.As(goError, &svmErr))
+	req.Equal(OpValidate, svmErr.Op)
+	req.Equal("Mayday", svmErr.Msg)
+}
+
+func TestError_NoMsg(t *testing.T) {
+	req := require.New(t)
+
+	err := newError(OpBuildImports, ErrAllocation, "")
+	req.EqualError(err, "svm error: build imports: allocation failed")
+	req.True(errors.Is(err, ErrAllocation))
+}
+
+func TestImportPanicError_IsTrap(t *testing.T) {
+	req := require.New(t)
+
+	var err error = &ImportPanicError{Import: "env.inc", Value: "boom"}
+	req.True(errors.Is(err, ErrTrap))
+	req.False(errors.Is(err, ErrTxFailed))
 }
@@ -0,0 +1,125 @@
+package svm
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// handle is a reference to a resource allocated by SVM, shared by all the copies
+// of its Go wrapper (Runtime, Imports or MemKVStore).
+//
+// Freeing a handle is idempotent, and using a freed handle fails with ErrFreed
+// instead of passing a dangling pointer to SVM. The resource is destroyed only once
+// it is both freed and no longer in use, either by an in-flight call or by another
+// resource depending on it (e.g. the Imports of a Runtime).
+type handle struct {
+	mu sync.Mutex
+
+	// The resource name, used for errors and leak reports.
+	name string
+
+	p       unsafe.Pointer
+	destroy func(p unsafe.Pointer)
+
+	freed bool
+	refs  int
+}
+
+var leakHandler struct {
+	sync.RWMutex
+	fn func(name string)
+}
+
+// SetLeakHandler sets a function which is called with the resource name (e.g. "runtime")
+// whenever a Runtime, Imports or MemKVStore is garbage collected without being freed.
+// It applies to the resources created after it is set, and a nil fn disables the reports.
+// The leaked resource is not destroyed, since it might still be referenced by SVM.
+func SetLeakHandler(fn func(name string)) {
+	leakHandler.Lock()
+	defer leakHandler.Unlock()
+
+	leakHandler.fn = fn
+}
+
+func newHandle(name string, p unsafe.Pointer, destroy func(p unsafe.Pointer)) *handle {
+	h := &handle{name: name, p: p, destroy: destroy}
+
+	leakHandler.RLock()
+	fn := leakHandler.fn
+	leakHandler.RUnlock()
+
+	if fn != nil {
+		runtime.SetFinalizer(h, func(h *handle) {
+			if !h.freed {
+				fn(h.name)
+			}
+		})
+	}
+
+	return h
+}
+
+// acquire returns the resource pointer, and holds the resource until release is called,
+// so it can't be destroyed meanwhile. It fails if the handle is nil or freed.
+func (h *handle) acquire(op Op) (unsafe.Pointer, error) {
+	if h == nil {
+		return nil, newError(op, ErrFreed, "uninitialized handle")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.freed {
+		return nil, newError(op, ErrFreed, fmt.Sprintf("%v is freed", h.name))
+	}
+
+	h.refs++
+	return h.p, nil
+}
+
+// release releases the resource acquired by acquire.
+func (h *handle) release() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.refs--
+	h.destroyIfUnused()
+}
+
+// free marks the handle as freed. Freeing a nil or an already freed handle is a no-op.
+func (h *handle) free() {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.freed {
+		return
+	}
+
+	h.freed = true
+	h.destroyIfUnused()
+}
+
+// isFreed reports whether the handle is nil or freed.
+func (h *handle) isFreed() bool {
+	if h == nil {
+		return true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.freed
+}
+
+func (h *handle) destroyIfUnused() {
+	if h.freed && h.refs == 0 && h.p != nil {
+		h.destroy(h.p)
+		h.p = nil
+	}
+}
@@ -0,0 +1,142 @@
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"runtime"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func newTestHandle(destroyed *int) *handle {
+	var v byte
+	return newHandle("test", unsafe.Pointer(&v), func(p unsafe.Pointer) { *destroyed++ })
+}
+
+func TestHandle_Free(t *testing.T) {
+	req := require.New(t)
+
+	destroyed := 0
+	h := newTestHandle(&destroyed)
+
+	p, err := h.acquire(OpExec)
+	req.NoError(err)
+	req.NotNil(p)
+	h.release()
+	req.Equal(0, destroyed)
+
+	h.free()
+	req.Equal(1, destroyed)
+	req.True(h.isFreed())
+
+	// Freeing is idempotent.
+	h.free()
+	req.Equal(1, destroyed)
+
+	_, err = h.acquire(OpExec)
+	req.EqualError(err, "svm error: exec: use of a freed handle: test is freed")
+	req.True(errors.Is(err, ErrFreed))
+}
+
+func TestHandle_FreeInUse(t *testing.T) {
+	req := require.New(t)
+
+	destroyed := 0
+	h := newTestHandle(&destroyed)
+
+	_, err := h.acquire(OpExec)
+	req.NoError(err)
+	_, err = h.acquire(OpExec)
+	req.NoError(err)
+
+	// The destruction is deferred until the handle is no longer in use.
+	h.free()
+	req.Equal(0, destroyed)
+	h.release()
+	req.Equal(0, destroyed)
+	h.release()
+	req.Equal(1, destroyed)
+}
+
+func TestHandle_Nil(t *testing.T) {
+	req := require.New(t)
+
+	var h *handle
+	h.free()
+	req.True(h.isFreed())
+
+	_, err := h.acquire(OpValidate)
+	req.EqualError(err, "svm error: validate: use of a freed handle: uninitialized handle")
+}
+
+func TestSetLeakHandler(t *testing.T) {
+	req := require.New(t)
+
+	leaked := make(chan string, 2)
+	SetLeakHandler(func(name string) { leaked <- name })
+	defer SetLeakHandler(nil)
+
+	destroyed := 0
+	newTestHandle(&destroyed)
+	newTestHandle(&destroyed).free()
+
+	for i := 0; i < 10 && len(leaked) == 0; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	req.Equal("test", <-leaked)
+	req.Len(leaked, 0)
+}
+
+func TestMemKVStore_Free(t *testing.T) {
+	req := require.New(t)
+
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+
+	kvCopy := kv
+	req.NoError(kv.Close())
+	kvCopy.Free()
+	req.True(kv.h.isFreed())
+
+	// A runtime can't be built with a freed kv-store.
+	_, err = NewRuntimeBuilder().WithMemKVStore(kv).Build()
+	req.True(errors.Is(err, ErrFreed))
+}
+
+func TestRuntime_Free(t *testing.T) {
+	req := require.New(t)
+
+	imports := newTestImports(req)
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithMemKVStore(kv).
+		Build()
+	req.NoError(err)
+
+	// The runtime holds its imports and kv-store.
+	imports.Free()
+	kv.Free()
+	req.False(imports.h.p == nil)
+	req.False(kv.h.p == nil)
+
+	templateAddr := deployTestTemplate(req, runtime)
+	spawnTestApp(req, runtime, templateAddr, 0)
+
+	runtimeCopy := runtime
+	req.NoError(runtime.Close())
+	runtimeCopy.Free()
+	req.True(imports.h.p == nil)
+	req.True(kv.h.p == nil)
+
+	appTemplate, err := EncodeAppTemplate(0, "name", []byte{}, DataLayout{})
+	req.NoError(err)
+	_, err = DeployTemplate(runtimeCopy, appTemplate, Address{}, emptyHostCtx(), false, 0)
+	req.True(errors.Is(err, ErrFreed))
+	req.EqualError(ValidateTemplate(runtimeCopy, appTemplate), "svm error: validate: use of a freed handle: runtime is freed")
+}
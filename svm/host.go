@@ -0,0 +1,151 @@
+package svm
+
+import (
+	"context"
+	"sync"
+	"unsafe"
+)
+
+// The runtime host object is never passed to SVM as a Go pointer, since
+// Go pointers must not be retained by C code (see the cgo pointer-passing rules).
+// Instead, SVM is given a handle, which is the address of a C-allocated cell,
+// and the host value itself is kept in a Go-side table keyed by that handle.
+
+// hostEntry is the Go-side state of a runtime host handle.
+type hostEntry struct {
+	value    interface{}
+	hasValue bool
+
+	mu sync.Mutex
+	// The panic recovered from an import function during the current transaction.
+	importPanic *ImportPanicError
+	// The request context of the current runtime call.
+	requestCtx context.Context
+}
+
+func (e *hostEntry) setRequestContext(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.requestCtx = ctx
+}
+
+// requestContext returns the request context of the current runtime call,
+// or context.Background() if there is none.
+func (e *hostEntry) requestContext() context.Context {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.requestCtx == nil {
+		return context.Background()
+	}
+	return e.requestCtx
+}
+
+// setImportPanic records the recovered import panic, unless one was already recorded.
+func (e *hostEntry) setImportPanic(err *ImportPanicError) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.importPanic == nil {
+		e.importPanic = err
+	}
+}
+
+// takeImportPanic returns the recorded import panic, if any, and clears it.
+func (e *hostEntry) takeImportPanic() *ImportPanicError {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	err := e.importPanic
+	e.importPanic = nil
+	return err
+}
+
+var hosts = struct {
+	sync.RWMutex
+	entries map[unsafe.Pointer]*hostEntry
+}{
+	entries: make(map[unsafe.Pointer]*hostEntry),
+}
+
+// newHostHandle allocates a new handle for the host entry.
+func newHostHandle(entry *hostEntry) unsafe.Pointer {
+	handle := cMalloc(1)
+
+	hosts.Lock()
+	defer hosts.Unlock()
+
+	hosts.entries[handle] = entry
+	return handle
+}
+
+// releaseHostHandle removes the handle's entry and frees the handle.
+// Releasing a nil handle is a no-op.
+func releaseHostHandle(handle unsafe.Pointer) {
+	if handle == nil {
+		return
+	}
+
+	hosts.Lock()
+	delete(hosts.entries, handle)
+	hosts.Unlock()
+
+	cFree(handle)
+}
+
+// lookupHost returns the entry of the runtime host handle of the given instance context.
+func lookupHost(ctx unsafe.Pointer) (*hostEntry, bool) {
+	return lookupHostHandle(cSvmInstanceContextHostGet(ctx))
+}
+
+// lookupHostHandle returns the entry of the runtime host handle.
+func lookupHostHandle(handle unsafe.Pointer) (*hostEntry, bool) {
+	hosts.RLock()
+	defer hosts.RUnlock()
+
+	entry, ok := hosts.entries[handle]
+	return entry, ok
+}
+
+// HostFromContext returns the host value set via RuntimeBuilder.WithHostValue
+// for the runtime running the given instance context.
+// It should be called only from within an import function, using its context argument.
+// The returned bool is false if no host value was set.
+func HostFromContext(ctx unsafe.Pointer) (interface{}, bool) {
+	entry, ok := lookupHost(ctx)
+	if !ok || !entry.hasValue {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// RequestContext returns the context passed to the command (e.g. ExecAppContext)
+// running the given instance context, or context.Background() if there is none.
+// It should be called only from within an import function, using its context argument.
+//
+// Since the runtime can't be interrupted, an import function may check whether
+// the context is done, and panic for failing the transaction (see ImportPanicError).
+func RequestContext(ctx unsafe.Pointer) context.Context {
+	entry, ok := lookupHost(ctx)
+	if !ok {
+		return context.Background()
+	}
+
+	return entry.requestContext()
+}
+
+// InstanceContextHostGet returns the host pointer set via RuntimeBuilder.WithHost
+// for the runtime running the given instance context, or nil if none was set.
+//
+// Deprecated: use RuntimeBuilder.WithHostValue and HostFromContext instead.
+func InstanceContextHostGet(ctx unsafe.Pointer) unsafe.Pointer {
+	entry, ok := lookupHost(ctx)
+	if !ok {
+		return nil
+	}
+
+	p, _ := entry.value.(unsafe.Pointer)
+	return p
+}
@@ -1,18 +1,205 @@
 package svm
 
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// HostCtxProtoVersion is the host context encoding protocol version.
+const HostCtxProtoVersion = 0
+
+// HostCtx represents the host context of a transaction, as fields keyed by their index.
+// Field indexes and field value lengths must fit in 2 bytes.
 type HostCtx map[uint32][]byte
 
 func NewHostCtx() HostCtx {
 	return make(HostCtx)
 }
 
-func (h HostCtx) Encode() []byte {
-	if len(h) == 0 {
-		var size int
-		size += 4 // proto version
-		size += 2 // #fields
-		return make([]byte, size, size)
+// SetBytes sets the field value to a clone of b.
+func (h HostCtx) SetBytes(index uint16, b []byte) {
+	h[uint32(index)] = append([]byte{}, b...)
+}
+
+// SetUint64 sets the field value to v, encoded as 8 Big-Endian bytes.
+func (h HostCtx) SetUint64(index uint16, v uint64) {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	h[uint32(index)] = b
+}
+
+// SetAddress sets the field value to addr.
+func (h HostCtx) SetAddress(index uint16, addr Address) {
+	h[uint32(index)] = append([]byte{}, addr[:]...)
+}
+
+// Bytes gets the field value.
+func (h HostCtx) Bytes(index uint16) ([]byte, bool) {
+	b, ok := h[uint32(index)]
+	return b, ok
+}
+
+// Uint64 gets the field value, as set by SetUint64.
+func (h HostCtx) Uint64(index uint16) (uint64, error) {
+	b, ok := h[uint32(index)]
+	if !ok {
+		return 0, fmt.Errorf("field #%v is missing", index)
+	}
+	if len(b) != 8 {
+		return 0, fmt.Errorf("field #%v is not a uint64; expected length: 8, given: %v", index, len(b))
+	}
+
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// Address gets the field value, as set by SetAddress.
+func (h HostCtx) Address(index uint16) (Address, error) {
+	b, ok := h[uint32(index)]
+	if !ok {
+		return Address{}, fmt.Errorf("field #%v is missing", index)
+	}
+	if len(b) != AddressLen {
+		return Address{}, fmt.Errorf("field #%v is not an address; expected length: %v, given: %v", index, AddressLen, len(b))
+	}
+
+	return bytesToAddress(b), nil
+}
+
+// Validate checks that all field indexes and field value lengths fit in 2 bytes.
+func (h HostCtx) Validate() error {
+	for index, value := range h {
+		if index > math.MaxUint16 {
+			return fmt.Errorf("invalid field index; max: %v, given: %v", math.MaxUint16, index)
+		}
+		if len(value) > math.MaxUint16 {
+			return fmt.Errorf("field #%v value is too long; max: %v, given: %v", index, math.MaxUint16, len(value))
+		}
+	}
+
+	return nil
+}
+
+// Encode encodes HostCtx according to the following format:
+//
+// +-----------------------------------------------------------+
+// |  proto version  |  #fields   |  field #1 index  |  field #1 |
+// |   (4 bytes)     |  (2 bytes) |    (2 bytes)     |  length   |
+// |                 |            |                  | (2 bytes) |
+// +-----------------------------------------------------------+
+// |  field #1 value  |  . . .  |  field #N index  |  field #N  |
+// | (field #1 length |         |    (2 bytes)     |  length    |
+// |     bytes)       |         |                  | (2 bytes)  |
+// +-----------------------------------------------------------+
+// |  field #N value   |
+// | (field #N length  |
+// |      bytes)       |
+// +-------------------+
+//
+// Fields are ordered by their index, so the encoding is deterministic.
+// All numbers byte order is Big-Endian.
+// Encode fails when HostCtx isn't valid; see Validate.
+func (h HostCtx) Encode() ([]byte, error) {
+	if err := h.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid host ctx: %w", err)
+	}
+
+	indexes := make([]int, 0, len(h))
+	for index := range h {
+		indexes = append(indexes, int(index))
+	}
+	sort.Ints(indexes)
+
+	buf := &bytes.Buffer{}
+
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, HostCtxProtoVersion)
+	buf.Write(b)
+
+	b = make([]byte, 2)
+	binary.BigEndian.PutUint16(b, uint16(len(h)))
+	buf.Write(b)
+
+	for _, index := range indexes {
+		value := h[uint32(index)]
+
+		b = make([]byte, 4)
+		binary.BigEndian.PutUint16(b, uint16(index))
+		binary.BigEndian.PutUint16(b[2:], uint16(len(value)))
+		buf.Write(b)
+		buf.Write(value)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// emptyHostCtx returns an encoded empty host context.
+func emptyHostCtx() []byte {
+	b, _ := NewHostCtx().Encode()
+	return b
+}
+
+// DecodeHostCtx decodes []byte slice according to the encoding format
+// defined in the HostCtx `Encode` method.
+func DecodeHostCtx(data []byte) (HostCtx, error) {
+	if len(data) == 0 {
+		return nil, errors.New("invalid input: empty data")
+	}
+
+	buf := bytes.NewBuffer(data)
+
+	next := buf.Next(4)
+	if len(next) < 4 {
+		return nil, fmt.Errorf("failed to decode proto version: "+
+			"bytes are missing; expected: 4, given: %v", len(next))
+	}
+	if version := binary.BigEndian.Uint32(next); version != HostCtxProtoVersion {
+		return nil, fmt.Errorf("unsupported proto version; expected: %v, given: %v",
+			HostCtxProtoVersion, version)
+	}
+
+	next = buf.Next(2)
+	if len(next) < 2 {
+		return nil, fmt.Errorf("failed to decode #fields: "+
+			"bytes are missing; expected: 2, given: %v", len(next))
+	}
+	numFields := int(binary.BigEndian.Uint16(next))
+
+	h := make(HostCtx, numFields)
+	prevIndex := -1
+
+	for i := 0; i < numFields; i++ {
+		next = buf.Next(4)
+		if len(next) < 4 {
+			return nil, fmt.Errorf("failed to decode field #%v header: "+
+				"bytes are missing; expected: 4, given: %v", i, len(next))
+		}
+
+		index := int(binary.BigEndian.Uint16(next))
+		length := int(binary.BigEndian.Uint16(next[2:]))
+
+		if index <= prevIndex {
+			return nil, fmt.Errorf("failed to decode field #%v: "+
+				"fields must be ordered by a unique index; previous: %v, given: %v", i, prevIndex, index)
+		}
+		prevIndex = index
+
+		value := buf.Next(length)
+		if len(value) < length {
+			return nil, fmt.Errorf("failed to decode field #%v value: "+
+				"bytes are missing; expected: %v, given: %v", i, length, len(value))
+		}
+
+		h[uint32(index)] = append([]byte{}, value...)
+	}
+
+	if buf.Len() > 0 {
+		return nil, fmt.Errorf("too many bytes; num expected: %v, num given: %v",
+			len(data)-buf.Len(), len(data))
 	}
 
-	panic("not implemented")
+	return h, nil
 }
@@ -0,0 +1,118 @@
+package svm
+
+import (
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestHostCtx_Encode_Empty(t *testing.T) {
+	req := require.New(t)
+
+	b, err := NewHostCtx().Encode()
+	req.NoError(err)
+	req.Equal([]byte{0, 0, 0, 0, 0, 0}, b)
+
+	h, err := DecodeHostCtx(b)
+	req.NoError(err)
+	req.Equal(NewHostCtx(), h)
+}
+
+func TestHostCtx_Encode(t *testing.T) {
+	req := require.New(t)
+
+	h := NewHostCtx()
+	h.SetBytes(3, []byte{0xAA, 0xBB, 0xCC})
+	h.SetBytes(1, []byte{0x10})
+	h.SetBytes(2, nil)
+
+	b, err := h.Encode()
+	req.NoError(err)
+	req.Equal([]byte{
+		0, 0, 0, 0, // proto version
+		0, 3, // #fields
+		0, 1, 0, 1, 0x10, // field #1
+		0, 2, 0, 0, // field #2
+		0, 3, 0, 3, 0xAA, 0xBB, 0xCC, // field #3
+	}, b)
+}
+
+func TestHostCtx_Encode_Decode(t *testing.T) {
+	req := require.New(t)
+
+	addr := bytesToAddress([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20})
+
+	h := NewHostCtx()
+	h.SetUint64(math.MaxUint16, math.MaxUint64-1)
+	h.SetAddress(7, addr)
+	h.SetBytes(0, []byte("hello"))
+
+	b, err := h.Encode()
+	req.NoError(err)
+	decoded, err := DecodeHostCtx(b)
+	req.NoError(err)
+	req.Equal(h, decoded)
+
+	v, err := decoded.Uint64(math.MaxUint16)
+	req.NoError(err)
+	req.Equal(uint64(math.MaxUint64-1), v)
+
+	a, err := decoded.Address(7)
+	req.NoError(err)
+	req.Equal(addr, a)
+
+	b, ok := decoded.Bytes(0)
+	req.True(ok)
+	req.Equal([]byte("hello"), b)
+
+	_, ok = decoded.Bytes(1)
+	req.False(ok)
+	_, err = decoded.Uint64(0)
+	req.EqualError(err, "field #0 is not a uint64; expected length: 8, given: 5")
+	_, err = decoded.Address(1)
+	req.EqualError(err, "field #1 is missing")
+}
+
+func TestHostCtx_Validate(t *testing.T) {
+	req := require.New(t)
+
+	h := NewHostCtx()
+	h[math.MaxUint16+1] = nil
+	req.EqualError(h.Validate(), "invalid field index; max: 65535, given: 65536")
+	_, err := h.Encode()
+	req.EqualError(err, "invalid host ctx: invalid field index; max: 65535, given: 65536")
+
+	h = NewHostCtx()
+	h.SetBytes(1, make([]byte, math.MaxUint16+1))
+	req.EqualError(h.Validate(), "field #1 value is too long; max: 65535, given: 65536")
+	_, err = h.Encode()
+	req.EqualError(err, "invalid host ctx: field #1 value is too long; max: 65535, given: 65536")
+}
+
+func TestDecodeHostCtx_Errors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := DecodeHostCtx(nil)
+	req.EqualError(err, "invalid input: empty data")
+
+	_, err = DecodeHostCtx([]byte{0, 0, 0})
+	req.EqualError(err, "failed to decode proto version: bytes are missing; expected: 4, given: 3")
+
+	_, err = DecodeHostCtx([]byte{0, 0, 0, 1, 0, 0})
+	req.EqualError(err, "unsupported proto version; expected: 0, given: 1")
+
+	_, err = DecodeHostCtx([]byte{0, 0, 0, 0, 0})
+	req.EqualError(err, "failed to decode #fields: bytes are missing; expected: 2, given: 1")
+
+	_, err = DecodeHostCtx([]byte{0, 0, 0, 0, 0, 1, 0, 1, 0})
+	req.EqualError(err, "failed to decode field #0 header: bytes are missing; expected: 4, given: 3")
+
+	_, err = DecodeHostCtx([]byte{0, 0, 0, 0, 0, 1, 0, 1, 0, 2, 0xAA})
+	req.EqualError(err, "failed to decode field #0 value: bytes are missing; expected: 2, given: 1")
+
+	_, err = DecodeHostCtx([]byte{0, 0, 0, 0, 0, 2, 0, 2, 0, 0, 0, 1, 0, 0})
+	req.EqualError(err, "failed to decode field #1: fields must be ordered by a unique index; previous: 2, given: 1")
+
+	_, err = DecodeHostCtx([]byte{0, 0, 0, 0, 0, 0, 0})
+	req.EqualError(err, "too many bytes; num expected: 6, num given: 7")
+}
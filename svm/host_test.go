@@ -0,0 +1,84 @@
+package svm
+
+import (
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+	"unsafe"
+)
+
+func TestHostHandle(t *testing.T) {
+	req := require.New(t)
+
+	entry := &hostEntry{value: "host", hasValue: true}
+	handle := newHostHandle(entry)
+	req.NotNil(handle)
+
+	other := newHostHandle(&hostEntry{})
+	req.NotEqual(handle, other)
+
+	hosts.RLock()
+	req.Equal(entry, hosts.entries[handle])
+	hosts.RUnlock()
+
+	releaseHostHandle(handle)
+	releaseHostHandle(other)
+	releaseHostHandle(nil)
+
+	hosts.RLock()
+	_, ok := hosts.entries[handle]
+	hosts.RUnlock()
+	req.False(ok)
+}
+
+func TestRuntime_HostValue(t *testing.T) {
+	req := require.New(t)
+
+	type counter struct {
+		value int32
+	}
+
+	host := func(ctx unsafe.Pointer) *counter {
+		v, ok := HostFromContext(ctx)
+		if !ok {
+			panic("host value is missing")
+		}
+		return v.(*counter)
+	}
+
+	ib := NewImportsBuilder()
+	ib, err := ib.AppendFunction("inc", func(ctx unsafe.Pointer, value int32) { host(ctx).value += value })
+	req.NoError(err)
+	ib, err = ib.AppendFunction("get", func(ctx unsafe.Pointer) int32 { return host(ctx).value })
+	req.NoError(err)
+	imports, err := ib.Build()
+	req.NoError(err)
+	defer imports.Free()
+
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+	defer kv.Free()
+
+	c := &counter{}
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithMemKVStore(kv).
+		WithHostValue(c).
+		Build()
+	req.NoError(err)
+	defer runtime.Free()
+
+	code, err := ioutil.ReadFile("testdata/counter_template.wasm")
+	req.NoError(err)
+	appTemplate, err := EncodeAppTemplate(0, "counter", code, DataLayout{4})
+	req.NoError(err)
+	deployTemplateResult, err := DeployTemplate(runtime, appTemplate, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+
+	spawnAppResult := spawnTestApp(req, runtime, deployTemplateResult.TemplateAddr, 0)
+
+	execAppResult := execTestApp(req, runtime, spawnAppResult.AppAddr, 2, Values{I32(7)}, spawnAppResult.InitialState)
+	req.Equal(int32(7), c.value)
+	execAppResult = execTestApp(req, runtime, spawnAppResult.AppAddr, 3, nil, execAppResult.NewState)
+	req.Equal(Values{I32(7)}, execAppResult.Returns)
+}
@@ -3,15 +3,26 @@ package svm
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"unsafe"
 )
 
+// Imports is a handle to the imported functions of a runtime. Like Runtime,
+// its copies share the same imports, and freeing it is idempotent.
 type Imports struct {
-	p unsafe.Pointer
+	h *handle
 }
 
+// Free frees the imports. They are destroyed, and their trampoline slots are released,
+// once the runtimes using them are.
 func (imports Imports) Free() {
-	cSvmImportsDestroy(imports)
+	imports.h.free()
+}
+
+// Close helps Imports to implement the io.Closer interface. It is equivalent to Free.
+func (imports Imports) Close() error {
+	imports.Free()
+	return nil
 }
 
 // ImportFunction represents a SVM runtime imported function.
@@ -23,11 +34,15 @@ type ImportFunction struct {
 
 	// The pointer to the cgo function implementation,
 	// something like `C.foo`.
+	// When nil, SVM calls the implementation through a generic trampoline.
 	cgoPointer unsafe.Pointer
 
 	// The namespace of the imported function.
 	namespace string
 
+	// The name of the imported function.
+	name string
+
 	// The function implementation signature as a WebAssembly signature.
 	args ValueTypes
 
@@ -35,16 +50,47 @@ type ImportFunction struct {
 	returns ValueTypes
 }
 
+// Namespace returns the namespace of the imported function.
+func (f ImportFunction) Namespace() string {
+	return f.namespace
+}
+
+// Name returns the name of the imported function.
+func (f ImportFunction) Name() string {
+	return f.name
+}
+
+// Params returns the params types of the imported function WebAssembly signature.
+func (f ImportFunction) Params() ValueTypes {
+	return f.args
+}
+
+// Returns returns the returns types of the imported function WebAssembly signature.
+func (f ImportFunction) Returns() ValueTypes {
+	return f.returns
+}
+
+// String helps ImportFunction to implement the Stringer interface.
+func (f ImportFunction) String() string {
+	return fmt.Sprintf("%v.%v %v -> %v", f.namespace, f.name, f.args, f.returns)
+}
+
+// importKey identifies an imported function by its namespace-qualified name.
+type importKey struct {
+	namespace string
+	name      string
+}
+
 type ImportsBuilder struct {
 	// All imports.
-	imports map[string]ImportFunction
+	imports map[importKey]ImportFunction
 
 	// Current namespace where to register the import.
 	currentNamespace string
 }
 
 func NewImportsBuilder() ImportsBuilder {
-	var imports = make(map[string]ImportFunction)
+	var imports = make(map[importKey]ImportFunction)
 	var currentNamespace = "env"
 
 	return ImportsBuilder{imports, currentNamespace}
@@ -56,17 +102,40 @@ func (ib ImportsBuilder) Namespace(namespace string) ImportsBuilder {
 	return ib
 }
 
-func (ib ImportsBuilder) AppendFunction(name string, implementation interface{}, cgoPointer unsafe.Pointer) (ImportsBuilder, error) {
+// AppendFunction appends an imported function to the current namespace.
+// The implementation can be any Go function, including a closure,
+// of type `func(ctx unsafe.Pointer, arguments ...) result`,
+// where the arguments and the optional result are either `int32` or `int64`.
+// SVM calls it through a generic trampoline, so no `//export`ed function is required.
+// A panic raised by the implementation is recovered, and fails the calling transaction
+// with an *ImportPanicError. This requires the `svmdep` build tag (see ImportPanicError).
+//
+// There can be at most 16 such import functions of the same signature alive in the process,
+// across all the built Imports, until they are freed. Beyond that, Build fails with ErrValidation.
+func (ib ImportsBuilder) AppendFunction(name string, implementation interface{}) (ImportsBuilder, error) {
+	return ib.AppendCgoFunction(name, implementation, nil)
+}
+
+// AppendCgoFunction appends an imported function to the current namespace,
+// which SVM calls directly through its cgo function pointer, something like `C.foo`.
+// The implementation must be an `//export`ed Go function, declared in the cgo preamble.
+// Unlike with AppendFunction, a panic raised by the implementation is not recovered.
+func (ib ImportsBuilder) AppendCgoFunction(name string, implementation interface{}, cgoPointer unsafe.Pointer) (ImportsBuilder, error) {
 	args, returns, err := validateImport(name, implementation)
 	if err != nil {
 		return ImportsBuilder{}, err
 	}
 
-	namespace := ib.currentNamespace
-	ib.imports[name] = ImportFunction{
+	key := importKey{ib.currentNamespace, name}
+	if _, ok := ib.imports[key]; ok {
+		return ImportsBuilder{}, fmt.Errorf("imported function `%v.%v` is already registered", key.namespace, key.name)
+	}
+
+	ib.imports[key] = ImportFunction{
 		implementation,
 		cgoPointer,
-		namespace,
+		key.namespace,
+		key.name,
 		args,
 		returns,
 	}
@@ -74,23 +143,69 @@ func (ib ImportsBuilder) AppendFunction(name string, implementation interface{},
 	return ib, nil
 }
 
-func (ib ImportsBuilder) Build() (Imports, error) {
-	imports := Imports{}
+// List returns the imported functions that will be built, ordered by namespace and name.
+func (ib ImportsBuilder) List() []ImportFunction {
+	list := make([]ImportFunction, 0, len(ib.imports))
+	for _, importFunction := range ib.imports {
+		list = append(list, importFunction)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].namespace != list[j].namespace {
+			return list[i].namespace < list[j].namespace
+		}
+		return list[i].name < list[j].name
+	})
+
+	return list
+}
 
-	if res := cSvmImportsAlloc(&imports.p, uint(len(ib.imports))); res != cSvmSuccess {
-		return Imports{}, fmt.Errorf("failed to allocate imports")
+func (ib ImportsBuilder) Build() (Imports, error) {
+	var p unsafe.Pointer
+	if res := cSvmImportsAlloc(&p, uint(len(ib.imports))); res != cSvmSuccess {
+		return Imports{}, newError(OpBuildImports, ErrAllocation, "")
 	}
 
-	for importName, importFunction := range ib.imports {
+	// The trampoline slots bound to the closure import functions.
+	var slots []int
+	imports := Imports{newHandle("imports", p, func(p unsafe.Pointer) {
+		cSvmImportsDestroy(p)
+
+		for _, slot := range slots {
+			unbindTrampoline(slot)
+		}
+	})}
+
+	for _, importFunction := range ib.List() {
+		cgoPointer := importFunction.cgoPointer
+		if cgoPointer == nil {
+			slot, err := bindTrampoline(
+				importFunction.namespace,
+				importFunction.name,
+				importFunction.implementation,
+				importFunction.args,
+				importFunction.returns,
+			)
+			if err != nil {
+				imports.Free()
+				return Imports{}, err
+			}
+
+			slots = append(slots, slot)
+			cgoPointer = cTrampoline(slot)
+		}
+
 		if err := cSvmImportFuncBuild(
-			imports,
+			p,
 			importFunction.namespace,
-			importName,
-			importFunction.cgoPointer,
+			importFunction.name,
+			cgoPointer,
 			importFunction.args,
 			importFunction.returns,
 		); err != nil {
-			return Imports{}, fmt.Errorf("failed to build import `%v`: %v", importName, err)
+			imports.Free()
+			return Imports{}, fmt.Errorf("failed to build import `%v.%v`: %w",
+				importFunction.namespace, importFunction.name, err)
 		}
 	}
 
@@ -0,0 +1,120 @@
+package svm
+
+import (
+	"fmt"
+	"go-svm/svm/wasm"
+	"strings"
+)
+
+// runtimeImports are the functions provided by the SVM runtime itself, under the `svm` namespace,
+// which templates import without them being registered via ImportsBuilder.
+// Only the ones whose signatures are exercised against the runtime, by the testdata
+// templates (e.g. `testdata/counter_template.wat`), are listed. A template importing
+// any other runtime function is reported as missing it.
+var runtimeImports = map[importKey]wasm.FuncType{
+	{"svm", "get32"}: {Params: []wasm.ValueType{wasm.I32}, Results: []wasm.ValueType{wasm.I32}},
+	{"svm", "set32"}: {Params: []wasm.ValueType{wasm.I32, wasm.I32}},
+}
+
+// ImportMismatch is a template function import whose signature differs from the provided one.
+type ImportMismatch struct {
+	// The template import.
+	Import wasm.Import
+
+	// The signature of the registered (or runtime-provided) import function.
+	Provided wasm.FuncType
+}
+
+// ImportsError is error type which represent the imports of a template which can't be satisfied,
+// as reported by CheckImports. It matches ErrValidation via `errors.Is`.
+type ImportsError struct {
+	// The function imports which are neither registered nor provided by the runtime.
+	Missing []wasm.Import
+
+	// The function imports whose signature differs from the provided one.
+	Mismatched []ImportMismatch
+
+	// The non-function imports (tables, memories and globals), which the runtime doesn't provide.
+	Unsupported []wasm.Import
+}
+
+// Error helps ImportsError to implement the error interface.
+func (e *ImportsError) Error() string {
+	var issues []string
+	for _, imp := range e.Missing {
+		issues = append(issues, fmt.Sprintf("missing import `%v` %v", imp.QualifiedName(), imp.Func))
+	}
+	for _, m := range e.Mismatched {
+		issues = append(issues, fmt.Sprintf("mismatched import `%v`: the template expects %v, but %v is provided",
+			m.Import.QualifiedName(), m.Import.Func, m.Provided))
+	}
+	for _, imp := range e.Unsupported {
+		issues = append(issues, fmt.Sprintf("unsupported %v import `%v`; only functions can be imported",
+			imp.Kind, imp.QualifiedName()))
+	}
+
+	return fmt.Sprintf("template imports can't be satisfied: %v", strings.Join(issues, "; "))
+}
+
+// Is reports whether the target is ErrValidation.
+func (e *ImportsError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// CheckImports checks that all the imports of a template wasm code are satisfied,
+// either by the functions registered in imports, or by the runtime-provided `svm` namespace
+// functions (e.g. `svm.get32`), before the template is handed to the runtime.
+// The unsatisfied imports are all reported at once, via an *ImportsError.
+func CheckImports(code []byte, imports ImportsBuilder) error {
+	module, err := wasm.Parse(code)
+	if err != nil {
+		return fmt.Errorf("failed to check imports: %w", err)
+	}
+
+	e := &ImportsError{}
+	for _, imp := range module.Imports {
+		if imp.Kind != wasm.KindFunc {
+			e.Unsupported = append(e.Unsupported, imp)
+			continue
+		}
+
+		provided, ok := imports.signature(importKey{imp.Module, imp.Name})
+		switch {
+		case !ok:
+			e.Missing = append(e.Missing, imp)
+		case !provided.Equal(imp.Func):
+			e.Mismatched = append(e.Mismatched, ImportMismatch{Import: imp, Provided: provided})
+		}
+	}
+
+	if len(e.Missing) > 0 || len(e.Mismatched) > 0 || len(e.Unsupported) > 0 {
+		return e
+	}
+
+	return nil
+}
+
+// signature returns the WebAssembly signature of a registered import function,
+// or of a runtime-provided one.
+func (ib ImportsBuilder) signature(key importKey) (wasm.FuncType, bool) {
+	f, ok := ib.imports[key]
+	if !ok {
+		sig, ok := runtimeImports[key]
+		return sig, ok
+	}
+
+	return wasm.FuncType{Params: wasmValueTypes(f.args), Results: wasmValueTypes(f.returns)}, true
+}
+
+func wasmValueTypes(types ValueTypes) []wasm.ValueType {
+	wasmTypes := make([]wasm.ValueType, len(types))
+	for i, t := range types {
+		if t == TypeI64 {
+			wasmTypes[i] = wasm.I64
+		} else {
+			wasmTypes[i] = wasm.I32
+		}
+	}
+
+	return wasmTypes
+}
@@ -0,0 +1,71 @@
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"go-svm/svm/wasm"
+	"go-svm/svm/wat"
+	"io/ioutil"
+	"testing"
+	"unsafe"
+)
+
+func TestCheckImports(t *testing.T) {
+	req := require.New(t)
+
+	code, err := ioutil.ReadFile("testdata/counter_template.wasm")
+	req.NoError(err)
+
+	ib := NewImportsBuilder()
+	ib, err = ib.AppendFunction("inc", func(ctx unsafe.Pointer, v int32) {})
+	req.NoError(err)
+	ib, err = ib.AppendFunction("get", func(ctx unsafe.Pointer) int32 { return 0 })
+	req.NoError(err)
+
+	// The `svm.get32` and `svm.set32` imports are provided by the runtime.
+	req.NoError(CheckImports(code, ib))
+}
+
+func TestCheckImports_Unsatisfied(t *testing.T) {
+	req := require.New(t)
+
+	code, err := wat.Compile([]byte(`(module
+		(import "svm" "get32" (func (param i64) (result i32)))
+		(import "svm" "unknown" (func))
+		(import "env" "inc" (func (param i32)))
+		(import "env" "get" (func (result i32)))
+		(import "env" "memory" (memory 1)))`))
+	req.NoError(err)
+
+	ib := NewImportsBuilder()
+	ib, err = ib.AppendFunction("inc", func(ctx unsafe.Pointer, v int64) {})
+	req.NoError(err)
+
+	err = CheckImports(code, ib)
+	req.True(errors.Is(err, ErrValidation))
+
+	var importsErr *ImportsError
+	req.True(errors.As(err, &importsErr))
+	req.Len(importsErr.Missing, 2)
+	req.Equal("svm.unknown", importsErr.Missing[0].QualifiedName())
+	req.Equal("env.get", importsErr.Missing[1].QualifiedName())
+	req.Len(importsErr.Mismatched, 2)
+	req.Equal("svm.get32", importsErr.Mismatched[0].Import.QualifiedName())
+	req.Equal("(i32) -> i32", importsErr.Mismatched[0].Provided.String())
+	req.Len(importsErr.Unsupported, 1)
+	req.Equal(wasm.KindMemory, importsErr.Unsupported[0].Kind)
+
+	req.EqualError(err, "template imports can't be satisfied: "+
+		"missing import `svm.unknown` () -> (); "+
+		"missing import `env.get` () -> i32; "+
+		"mismatched import `svm.get32`: the template expects (i64) -> i32, but (i32) -> i32 is provided; "+
+		"mismatched import `env.inc`: the template expects (i32) -> (), but (i64) -> () is provided; "+
+		"unsupported memory import `env.memory`; only functions can be imported")
+}
+
+func TestCheckImports_Malformed(t *testing.T) {
+	req := require.New(t)
+
+	err := CheckImports([]byte("wasm"), NewImportsBuilder())
+	req.EqualError(err, "failed to check imports: malformed wasm module at offset 0: invalid magic number")
+}
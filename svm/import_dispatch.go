@@ -0,0 +1,165 @@
+package svm
+
+//go:generate go run ../cmd/gen_trampolines -out trampolines.go
+
+// #include <stdint.h>
+import "C"
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"sync"
+	"unsafe"
+)
+
+// Import functions given without a cgo function pointer are called by SVM through a generated
+// C trampoline (see `trampolines.go`). Every trampoline is identified by a unique slot number,
+// and the slots are grouped by their signature shape. Building an import binds a free slot
+// of its shape to the import Go function, so the trampoline can dispatch the call to it.
+//
+// SVM passes no per-import data to an import function (see `svm_import_func_build` in svm.h),
+// only its wasmer ctx, so the trampoline slot is the only way to tell the imports apart. Thus the number of live
+// imports of a shape, across all the built Imports of the process, is limited to
+// trampolineSlotsPerShape. The slots are released once their Imports are freed.
+
+// trampolineShape is an import function signature shape.
+type trampolineShape struct {
+	params  ValueTypes
+	returns ValueTypes
+}
+
+func (s trampolineShape) equal(params, returns ValueTypes) bool {
+	return valueTypesEqual(s.params, params) && valueTypesEqual(s.returns, returns)
+}
+
+func valueTypesEqual(a, b ValueTypes) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// trampolineBinding is an import function bound to a trampoline slot.
+type trampolineBinding struct {
+	namespace string
+	name      string
+	fn        reflect.Value
+}
+
+var trampolines = struct {
+	sync.RWMutex
+	bindings map[int]*trampolineBinding
+}{
+	bindings: make(map[int]*trampolineBinding),
+}
+
+// bindTrampoline binds the import function to a free trampoline slot of its shape,
+// and returns the slot number.
+func bindTrampoline(namespace, name string, fn interface{}, params, returns ValueTypes) (int, error) {
+	shapeIndex := -1
+	for i, s := range trampolineShapes {
+		if s.equal(params, returns) {
+			shapeIndex = i
+			break
+		}
+	}
+	if shapeIndex == -1 {
+		return 0, newError(OpBuildImports, ErrValidation, fmt.Sprintf("unsupported signature "+
+			"for the `%v.%v` imported function; params: %v, returns: %v", namespace, name, params, returns))
+	}
+
+	trampolines.Lock()
+	defer trampolines.Unlock()
+
+	first := shapeIndex * trampolineSlotsPerShape
+	for slot := first; slot < first+trampolineSlotsPerShape; slot++ {
+		if _, ok := trampolines.bindings[slot]; !ok {
+			trampolines.bindings[slot] = &trampolineBinding{namespace, name, reflect.ValueOf(fn)}
+			return slot, nil
+		}
+	}
+
+	return 0, newError(OpBuildImports, ErrValidation, fmt.Sprintf("failed to bind the `%v.%v` imported function: "+
+		"all %v trampolines of its signature are in use", namespace, name, trampolineSlotsPerShape))
+}
+
+// unbindTrampoline releases the trampoline slot, so it can be bound again.
+func unbindTrampoline(slot int) {
+	trampolines.Lock()
+	defer trampolines.Unlock()
+
+	delete(trampolines.bindings, slot)
+}
+
+// dispatchImport calls the import function bound to the trampoline slot.
+//
+// A panic raised by the import function must not unwind through the runtime,
+// so with the `svm-dep` shim, which can trap the runtime (see importTrapSupported),
+// it is recovered and recorded on the runtime host entry, and trap is returned.
+// The trampoline then traps the runtime, which aborts the transaction (see ImportPanicError).
+// Otherwise, or without a host entry to record the panic on, the panic is re-raised.
+func dispatchImport(ctx unsafe.Pointer, host *hostEntry, slot int, args []int64) (ret int64, trap bool) {
+	trampolines.RLock()
+	binding, ok := trampolines.bindings[slot]
+	trampolines.RUnlock()
+
+	if !ok {
+		panic(fmt.Sprintf("no imported function is bound to trampoline #%v", slot))
+	}
+
+	if host != nil && importTrapSupported {
+		defer func() {
+			if v := recover(); v != nil {
+				host.setImportPanic(&ImportPanicError{
+					Import: binding.namespace + "." + binding.name,
+					Value:  v,
+					Stack:  debug.Stack(),
+				})
+				ret, trap = 0, true
+			}
+		}()
+	}
+
+	fnType := binding.fn.Type()
+	in := make([]reflect.Value, len(args)+1)
+	in[0] = reflect.ValueOf(ctx)
+	for i, arg := range args {
+		v := reflect.New(fnType.In(i + 1)).Elem()
+		v.SetInt(arg)
+		in[i+1] = v
+	}
+
+	out := binding.fn.Call(in)
+	if len(out) == 0 {
+		return 0, false
+	}
+
+	return out[0].Int(), false
+}
+
+// svmImportDispatch is called by the trampolines, via `svm_import_dispatch`,
+// which traps the runtime when trap is set.
+//
+//export svmImportDispatch
+func svmImportDispatch(ctx unsafe.Pointer, slot C.int, args *C.int64_t, nargs C.int, trap *C.int) C.int64_t {
+	goArgs := make([]int64, int(nargs))
+	if nargs > 0 {
+		cArgs := (*[1 << 10]C.int64_t)(unsafe.Pointer(args))[:nargs:nargs]
+		for i, arg := range cArgs {
+			goArgs[i] = int64(arg)
+		}
+	}
+
+	host, _ := lookupHost(ctx)
+	ret, trapped := dispatchImport(ctx, host, int(slot), goArgs)
+	if trapped {
+		*trap = 1
+	}
+
+	return C.int64_t(ret)
+}
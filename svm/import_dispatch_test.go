@@ -0,0 +1,213 @@
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+	"unsafe"
+)
+
+func TestTrampolineShapes(t *testing.T) {
+	req := require.New(t)
+
+	// Every shape is unique.
+	for i, a := range trampolineShapes {
+		for j, b := range trampolineShapes {
+			if i != j {
+				req.False(a.equal(b.params, b.returns), "shapes #%v and #%v are equal", i, j)
+			}
+		}
+	}
+}
+
+func TestBindTrampoline_Dispatch(t *testing.T) {
+	req := require.New(t)
+
+	var gotCtx unsafe.Pointer
+	var gotA int32
+	var gotB int64
+	fn := func(ctx unsafe.Pointer, a int32, b int64) int32 {
+		gotCtx, gotA, gotB = ctx, a, b
+		return a + int32(b)
+	}
+
+	slot, err := bindTrampoline("env", "add", fn, ValueTypes{TypeI32, TypeI64}, ValueTypes{TypeI32})
+	req.NoError(err)
+	defer unbindTrampoline(slot)
+
+	ctx := unsafe.Pointer(&gotA)
+	res, trap := dispatchImport(ctx, nil, slot, []int64{-3, 10})
+	req.Equal(int64(7), res)
+	req.False(trap)
+	req.Equal(ctx, gotCtx)
+	req.Equal(int32(-3), gotA)
+	req.Equal(int64(10), gotB)
+
+	// No return value.
+	called := false
+	slot2, err := bindTrampoline("env", "noop", func(ctx unsafe.Pointer) { called = true }, nil, nil)
+	req.NoError(err)
+	defer unbindTrampoline(slot2)
+
+	res, trap = dispatchImport(nil, nil, slot2, nil)
+	req.Equal(int64(0), res)
+	req.False(trap)
+	req.True(called)
+}
+
+func TestBindTrampoline_Errors(t *testing.T) {
+	req := require.New(t)
+
+	params := ValueTypes{TypeI32, TypeI32, TypeI32, TypeI32, TypeI32}
+	_, err := bindTrampoline("env", "foo", func() {}, params, nil)
+	req.True(errors.Is(err, ErrValidation))
+	req.EqualError(err, "svm error: build imports: validation failed: "+
+		"unsupported signature for the `env.foo` imported function; params: [0 0 0 0 0], returns: []")
+
+	// Exhaust all the slots of a shape.
+	params = ValueTypes{TypeI64, TypeI64, TypeI64, TypeI64}
+	returns := ValueTypes{TypeI64}
+	var slots []int
+	for i := 0; i < trampolineSlotsPerShape; i++ {
+		slot, err := bindTrampoline("env", "foo", func() {}, params, returns)
+		req.NoError(err)
+		slots = append(slots, slot)
+	}
+
+	_, err = bindTrampoline("env", "foo", func() {}, params, returns)
+	req.True(errors.Is(err, ErrValidation))
+	req.EqualError(err, "svm error: build imports: validation failed: "+
+		"failed to bind the `env.foo` imported function: all 16 trampolines of its signature are in use")
+
+	// Releasing a slot makes it available again.
+	unbindTrampoline(slots[3])
+	slot, err := bindTrampoline("env", "foo", func() {}, params, returns)
+	req.NoError(err)
+	req.Equal(slots[3], slot)
+
+	for _, slot := range slots {
+		unbindTrampoline(slot)
+	}
+}
+
+func TestImportsBuilder_Closures(t *testing.T) {
+	req := require.New(t)
+
+	var counter int32
+
+	ib := NewImportsBuilder()
+	ib, err := ib.AppendFunction("inc", func(ctx unsafe.Pointer, value int32) { counter += value })
+	req.NoError(err)
+	ib, err = ib.AppendFunction("get", func(ctx unsafe.Pointer) int32 { return counter })
+	req.NoError(err)
+	imports, err := ib.Build()
+	req.NoError(err)
+	defer imports.Free()
+
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+	defer kv.Free()
+
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithMemKVStore(kv).
+		Build()
+	req.NoError(err)
+	defer runtime.Free()
+
+	code, err := ioutil.ReadFile("testdata/counter_template.wasm")
+	req.NoError(err)
+	appTemplate, err := EncodeAppTemplate(0, "counter", code, DataLayout{4})
+	req.NoError(err)
+	deployTemplateResult, err := DeployTemplate(runtime, appTemplate, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+
+	spawnAppResult := spawnTestApp(req, runtime, deployTemplateResult.TemplateAddr, 0)
+
+	// `host_inc` and `host_get` call the `env.inc` and `env.get` closures.
+	execAppResult := execTestApp(req, runtime, spawnAppResult.AppAddr, 2, Values{I32(25)}, spawnAppResult.InitialState)
+	req.Equal(int32(25), counter)
+	execAppResult = execTestApp(req, runtime, spawnAppResult.AppAddr, 3, nil, execAppResult.NewState)
+	req.Equal(Values{I32(25)}, execAppResult.Returns)
+}
+
+func TestDispatchImport_Panic(t *testing.T) {
+	req := require.New(t)
+
+	fn := func(ctx unsafe.Pointer, a int32) int32 {
+		panic("boom")
+	}
+
+	slot, err := bindTrampoline("env", "boom", fn, ValueTypes{TypeI32}, ValueTypes{TypeI32})
+	req.NoError(err)
+	defer unbindTrampoline(slot)
+
+	// Without a host entry, the panic is re-raised.
+	req.PanicsWithValue("boom", func() { dispatchImport(nil, nil, slot, []int64{1}) })
+
+	host := &hostEntry{}
+	if !importTrapSupported {
+		// The runtime can't be trapped without the shim, so the panic is re-raised.
+		req.PanicsWithValue("boom", func() { dispatchImport(nil, host, slot, []int64{1}) })
+		req.Nil(host.takeImportPanic())
+		return
+	}
+
+	res, trap := dispatchImport(nil, host, slot, []int64{1})
+	req.Equal(int64(0), res)
+	req.True(trap)
+
+	err = host.takeImportPanic()
+	req.EqualError(err, "import function `env.boom` panicked: boom")
+	panicErr := err.(*ImportPanicError)
+	req.Equal("boom", panicErr.Value)
+	req.Contains(string(panicErr.Stack), "TestDispatchImport_Panic")
+	req.Nil(host.takeImportPanic())
+}
+
+func TestImportsBuilder_Panic(t *testing.T) {
+	if !importTrapSupported {
+		t.Skip("import function panics are recovered only with the svm-dep shim; build with the `svmdep` build tag")
+	}
+
+	req := require.New(t)
+
+	ib := NewImportsBuilder()
+	ib, err := ib.AppendFunction("inc", func(ctx unsafe.Pointer, value int32) { panic("inc panicked") })
+	req.NoError(err)
+	ib, err = ib.AppendFunction("get", func(ctx unsafe.Pointer) int32 { return 0 })
+	req.NoError(err)
+	imports, err := ib.Build()
+	req.NoError(err)
+	defer imports.Free()
+
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+	defer kv.Free()
+
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithMemKVStore(kv).
+		Build()
+	req.NoError(err)
+	defer runtime.Free()
+
+	code, err := ioutil.ReadFile("testdata/counter_template.wasm")
+	req.NoError(err)
+	appTemplate, err := EncodeAppTemplate(0, "counter", code, DataLayout{4})
+	req.NoError(err)
+	deployTemplateResult, err := DeployTemplate(runtime, appTemplate, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+
+	spawnAppResult := spawnTestApp(req, runtime, deployTemplateResult.TemplateAddr, 0)
+
+	appTx, err := EncodeAppTx(0, spawnAppResult.AppAddr, 2, nil, Values{I32(1)})
+	req.NoError(err)
+	_, err = ExecApp(runtime, appTx, spawnAppResult.InitialState, emptyHostCtx(), false, 0)
+	req.EqualError(err, "import function `env.inc` panicked: inc panicked")
+	req.IsType(&ImportPanicError{}, err)
+
+	// The runtime is still usable afterwards.
+	execTestApp(req, runtime, spawnAppResult.AppAddr, 3, nil, spawnAppResult.InitialState)
+}
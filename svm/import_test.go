@@ -0,0 +1,49 @@
+package svm
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"unsafe"
+)
+
+func TestImportsBuilder_Namespaces(t *testing.T) {
+	req := require.New(t)
+
+	get32 := func(ctx unsafe.Pointer, varID int32) int32 { return 0 }
+	get64 := func(ctx unsafe.Pointer, varID int32) int64 { return 0 }
+
+	ib := NewImportsBuilder()
+	ib, err := ib.AppendFunction("get", get32)
+	req.NoError(err)
+	ib, err = ib.Namespace("svm").AppendFunction("get", get64)
+	req.NoError(err)
+	ib, err = ib.Namespace("aaa").AppendFunction("inc", func(ctx unsafe.Pointer, v int64) {})
+	req.NoError(err)
+
+	list := ib.List()
+	req.Len(list, 3)
+
+	req.Equal("aaa", list[0].Namespace())
+	req.Equal("inc", list[0].Name())
+	req.Equal(ValueTypes{TypeI64}, list[0].Params())
+	req.Equal(ValueTypes{}, list[0].Returns())
+
+	req.Equal("env.get [0] -> [0]", list[1].String())
+	req.Equal("svm.get [0] -> [1]", list[2].String())
+}
+
+func TestImportsBuilder_Duplicate(t *testing.T) {
+	req := require.New(t)
+
+	get := func(ctx unsafe.Pointer) int32 { return 0 }
+
+	ib := NewImportsBuilder()
+	ib, err := ib.AppendFunction("get", get)
+	req.NoError(err)
+
+	_, err = ib.AppendFunction("get", get)
+	req.EqualError(err, "imported function `env.get` is already registered")
+
+	_, err = ib.Namespace("env").AppendCgoFunction("get", get, nil)
+	req.EqualError(err, "imported function `env.get` is already registered")
+}
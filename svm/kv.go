@@ -1,23 +1,33 @@
 package svm
 
-import (
-	"fmt"
-	"unsafe"
-)
+import "unsafe"
 
+// MemKVStore is a handle to an in-memory kv-store. Like Runtime,
+// its copies share the same kv-store, and freeing it is idempotent.
 type MemKVStore struct {
-	p unsafe.Pointer
+	h *handle
+
+	// lock is the lock of the runtimes using the kv-store (see Runtime.lock),
+	// since the kv-store isn't safe for concurrent use either.
+	lock chan struct{}
 }
 
 func NewMemKVStore() (MemKVStore, error) {
 	var p unsafe.Pointer
 	if res := cSvmMemoryKVCreate(&p); res != cSvmSuccess {
-		return MemKVStore{}, fmt.Errorf("failed to create memory kv-store")
+		return MemKVStore{}, newError(OpCreateKV, ErrAllocation, "")
 	}
 
-	return MemKVStore{p}, nil
+	return MemKVStore{newHandle("memory kv-store", p, cSvmMemKVDestroy), make(chan struct{}, 1)}, nil
 }
 
+// Free frees the kv-store. It is destroyed once the runtimes using it are.
 func (kv MemKVStore) Free() {
-	cSvmMemKVDestroy(kv)
+	kv.h.free()
+}
+
+// Close helps MemKVStore to implement the io.Closer interface. It is equivalent to Free.
+func (kv MemKVStore) Close() error {
+	kv.Free()
+	return nil
 }
@@ -0,0 +1,78 @@
+package svm
+
+// ExecOptions are the options of a transaction call into the runtime
+// (see Runtime.Deploy, Runtime.Spawn and Runtime.Exec).
+// The runtime-wide defaults are set via RuntimeBuilder.WithExecOptions.
+// The options given to a call override the defaults field by field:
+// a nil field keeps the default one. For example, the following call
+// still uses the default gas options:
+//
+//	res, err := runtime.Exec(ctx, appTx, appState, &svm.ExecOptions{HostCtx: hostCtx})
+type ExecOptions struct {
+	// The encoded host context (see HostCtx.Encode).
+	// When nil, the default one is used, or an empty host context if it isn't set either.
+	HostCtx []byte
+
+	// The gas options. When nil, the default ones are used, or the gas
+	// isn't metered if they aren't set either.
+	Gas *GasOptions
+}
+
+// GasOptions are the gas options of a transaction call.
+type GasOptions struct {
+	// Whether the gas used by the transaction is metered.
+	Metering bool
+
+	// The maximum gas the transaction may use, when Metering is set.
+	Limit uint64
+}
+
+// mergeExecOptions returns the defaults, overridden by the non-nil fields of opts, if any.
+func mergeExecOptions(defaults ExecOptions, opts *ExecOptions) *ExecOptions {
+	if opts == nil {
+		return &defaults
+	}
+
+	if opts.HostCtx != nil {
+		defaults.HostCtx = opts.HostCtx
+	}
+	if opts.Gas != nil {
+		defaults.Gas = opts.Gas
+	}
+
+	return &defaults
+}
+
+// adapterExecOptions returns the options of a call made by the free-function commands,
+// such as ExecApp, which take the host ctx as a parameter. Unlike with ExecOptions,
+// a nil hostCtx means an empty host ctx, rather than the runtime default one.
+func adapterExecOptions(hostCtx []byte, gas *GasOptions) *ExecOptions {
+	if hostCtx == nil {
+		hostCtx = emptyHostCtx()
+	}
+
+	return &ExecOptions{HostCtx: hostCtx, Gas: gas}
+}
+
+// gasMetering returns whether the gas used by the transaction is metered.
+func (o *ExecOptions) gasMetering() bool {
+	return o.Gas != nil && o.Gas.Metering
+}
+
+// gasLimit returns the maximum gas the transaction may use, when it's metered.
+func (o *ExecOptions) gasLimit() uint64 {
+	if o.Gas == nil {
+		return 0
+	}
+
+	return o.Gas.Limit
+}
+
+// encodedHostCtx returns the encoded host context, or an empty host context if it isn't set.
+func (o *ExecOptions) encodedHostCtx() []byte {
+	if o.HostCtx == nil {
+		return emptyHostCtx()
+	}
+
+	return o.HostCtx
+}
@@ -0,0 +1,120 @@
+package svm
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExecOptions_EncodedHostCtx(t *testing.T) {
+	req := require.New(t)
+
+	opts := &ExecOptions{}
+	req.Equal(emptyHostCtx(), opts.encodedHostCtx())
+
+	hostCtx := NewHostCtx()
+	hostCtx.SetUint64(1, 10)
+	encoded, err := hostCtx.Encode()
+	req.NoError(err)
+	opts.HostCtx = encoded
+	req.Equal(encoded, opts.encodedHostCtx())
+}
+
+func TestRuntime_Options(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+	defer runtime.Free()
+
+	gas := &GasOptions{Metering: true, Limit: 100}
+	runtime.defaultOptions = ExecOptions{Gas: gas}
+	req.Equal(ExecOptions{Gas: gas}, runtime.DefaultExecOptions())
+	req.Equal(&ExecOptions{Gas: gas}, runtime.options(nil))
+
+	// The given options override the defaults field by field.
+	hostCtx := emptyHostCtx()
+	req.Equal(&ExecOptions{HostCtx: hostCtx, Gas: gas}, runtime.options(&ExecOptions{HostCtx: hostCtx}))
+
+	opts := &ExecOptions{HostCtx: hostCtx, Gas: &GasOptions{}}
+	req.Equal(opts, runtime.options(opts))
+
+	// The defaults are left as is.
+	req.Equal(ExecOptions{Gas: gas}, runtime.DefaultExecOptions())
+}
+
+func TestAdapterExecOptions(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+	defer runtime.Free()
+
+	hostCtx := NewHostCtx()
+	hostCtx.SetUint64(1, 10)
+	encoded, err := hostCtx.Encode()
+	req.NoError(err)
+	runtime.defaultOptions = ExecOptions{HostCtx: encoded}
+
+	// A nil host ctx given to a free-function command is an empty one, not the default one.
+	gas := &GasOptions{Metering: true, Limit: 100}
+	opts := runtime.options(adapterExecOptions(nil, gas))
+	req.Equal(emptyHostCtx(), opts.encodedHostCtx())
+	req.Equal(gas, opts.Gas)
+
+	opts = runtime.options(adapterExecOptions(nil, nil))
+	req.Equal(emptyHostCtx(), opts.encodedHostCtx())
+	req.Nil(opts.Gas)
+
+	other := NewHostCtx()
+	other.SetUint64(2, 20)
+	otherEncoded, err := other.Encode()
+	req.NoError(err)
+	req.Equal(otherEncoded, runtime.options(adapterExecOptions(otherEncoded, nil)).encodedHostCtx())
+}
+
+func TestRuntime_DefaultExecOptions(t *testing.T) {
+	req := require.New(t)
+
+	imports := newTestImports(req)
+	defer imports.Free()
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+	defer kv.Free()
+
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithMemKVStore(kv).
+		WithExecOptions(ExecOptions{Gas: &GasOptions{Metering: true, Limit: 1}}).
+		Build()
+	req.NoError(err)
+	defer runtime.Free()
+
+	templateAddr := deployTestTemplate(req, runtime)
+
+	spawnApp, err := EncodeSpawnApp(0, templateAddr, storageIncFuncIndex, nil, Values{I32(5)})
+	req.NoError(err)
+
+	// The default options run out of gas.
+	res, err := runtime.Spawn(context.Background(), spawnApp, Address{}, nil)
+	req.NoError(err)
+	req.False(res.Success)
+	req.True(errors.Is(res.Err, ErrTxFailed), "unexpected error: %v", res.Err)
+
+	// The given options without gas options still use the default ones.
+	res, err = runtime.Spawn(context.Background(), spawnApp, Address{}, &ExecOptions{HostCtx: emptyHostCtx()})
+	req.NoError(err)
+	req.True(errors.Is(res.Err, ErrTxFailed), "unexpected error: %v", res.Err)
+
+	// Given options override the defaults.
+	res, err = runtime.Spawn(context.Background(), spawnApp, Address{}, &ExecOptions{Gas: &GasOptions{}})
+	req.NoError(err)
+	req.True(res.Success, "spawn-app failed: %v", res.Err)
+
+	appTx, err := EncodeAppTx(0, res.AppAddr, storageGetFuncIndex, nil, nil)
+	req.NoError(err)
+	execRes, err := runtime.Exec(context.Background(), appTx, res.InitialState, &ExecOptions{Gas: &GasOptions{}})
+	req.NoError(err)
+	req.Equal(Values{I32(5)}, execRes.Returns)
+}
@@ -0,0 +1,92 @@
+package svm
+
+import "errors"
+
+// The receipts are read via the runtime receipt helpers. The pure-Go receipt parsers
+// of the `codec` package aren't used, since their layout isn't checked against
+// the runtime receipts yet (see TestReceiptVectors).
+
+// receiptFailure converts the error returned by a runtime receipt helper for a failed
+// transaction, which holds its failure reason, to an ErrTxFailed Error of the given op.
+func receiptFailure(op Op, err error) error {
+	var svmErr *Error
+	if errors.As(err, &svmErr) {
+		return newError(op, ErrTxFailed, svmErr.Msg)
+	}
+
+	return wrapError(op, ErrTxFailed, err)
+}
+
+// readDeployReceipt reads the result out of a `deploy-template` receipt.
+func readDeployReceipt(receipt []byte) (*DeployTemplateResult, error) {
+	// The template address is only available for a successful deploy,
+	// so its error holds the failure reason otherwise.
+	templateAddr, err := cSvmTemplateReceiptAddr(receipt)
+	if err != nil {
+		return nil, receiptFailure(OpDeploy, err)
+	}
+
+	gasUsed, err := cSvmTemplateReceiptGas(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeployTemplateResult{
+		Receipt:      receipt,
+		TemplateAddr: templateAddr,
+		GasUsed:      gasUsed,
+	}, nil
+}
+
+// readSpawnReceipt reads the result out of a `spawn-app` receipt.
+func readSpawnReceipt(receipt []byte) (*SpawnAppResult, error) {
+	if err := cSvmAppReceiptStatus(receipt); err != nil {
+		return &SpawnAppResult{
+			Receipt: receipt,
+			Err:     receiptFailure(OpSpawn, err),
+		}, nil
+	}
+
+	res := &SpawnAppResult{Receipt: receipt, Success: true, GasUsedKnown: true}
+
+	var err error
+	if res.InitialState, err = cSvmAppReceiptState(receipt); err != nil {
+		return nil, err
+	}
+	if res.AppAddr, err = cSvmAppReceiptAddr(receipt); err != nil {
+		return nil, err
+	}
+	if res.Returns, err = cSvmAppReceiptReturns(receipt); err != nil {
+		return nil, err
+	}
+	if res.GasUsed, err = cSvmAppReceiptGas(receipt); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// readExecReceipt reads the result out of an `exec-app` receipt.
+func readExecReceipt(op Op, receipt []byte) (*ExecAppResult, error) {
+	if err := cSvmExecReceiptStatus(op, receipt); err != nil {
+		return &ExecAppResult{
+			Receipt: receipt,
+			Err:     receiptFailure(op, err),
+		}, nil
+	}
+
+	res := &ExecAppResult{Receipt: receipt, Success: true, GasUsedKnown: true}
+
+	var err error
+	if res.NewState, err = cSvmExecReceiptState(op, receipt); err != nil {
+		return nil, err
+	}
+	if res.Returns, err = cSvmExecReceiptReturns(op, receipt); err != nil {
+		return nil, err
+	}
+	if res.GasUsed, err = cSvmExecReceiptGas(op, receipt); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
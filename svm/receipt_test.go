@@ -0,0 +1,21 @@
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReceiptFailure(t *testing.T) {
+	req := require.New(t)
+
+	err := receiptFailure(OpQuery, newError(OpExec, ErrRuntime, "function `inc` not found"))
+	req.EqualError(err, "svm error: query: transaction failed: function `inc` not found")
+	req.True(errors.Is(err, ErrTxFailed))
+	req.False(errors.Is(err, ErrRuntime))
+
+	var svmErr *Error
+	req.True(errors.As(err, &svmErr))
+	req.Equal(OpQuery, svmErr.Op)
+	req.Equal("function `inc` not found", svmErr.Msg)
+}
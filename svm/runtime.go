@@ -2,23 +2,132 @@ package svm
 
 import "C"
 import (
+	"context"
 	"fmt"
 	"unsafe"
 )
 
+// Runtime is a handle to an SVM runtime. Its copies share the same runtime,
+// so freeing any of them frees it for all, and freeing it again is a no-op.
+// Using a freed runtime fails with ErrFreed.
+// A Runtime may be used from several goroutines, and its calls are serialized
+// (see the package documentation for the threading model).
 type Runtime struct {
-	p unsafe.Pointer
+	h    *handle
+	host unsafe.Pointer
+
+	// lock is a binary semaphore serializing the calls into the runtime,
+	// since the SVM runtime isn't safe for concurrent use.
+	// The runtimes sharing a MemKVStore share its lock.
+	lock chan struct{}
+
+	// The default options of the transaction calls.
+	defaultOptions ExecOptions
+
+	// The kv-store backing the runtime, for reading the apps storage and running queries:
+	// the in-memory one if memKV isn't nil, or else the disk one located at diskKVPath.
+	memKV      unsafe.Pointer
+	diskKVPath string
+
+	// The runtime imports, for creating the query runtimes (see Runtime.Query).
+	imports unsafe.Pointer
 }
 
+// Free frees the runtime. It is destroyed, along with its host handle, once the
+// in-flight calls using it return, and then releases its Imports and MemKVStore.
 func (r Runtime) Free() {
-	cSvmRuntimeDestroy(r)
+	r.h.free()
+}
+
+// Close helps Runtime to implement the io.Closer interface. It is equivalent to Free.
+func (r Runtime) Close() error {
+	r.Free()
+	return nil
+}
+
+// DefaultExecOptions returns the runtime default options of the transaction calls,
+// as set via RuntimeBuilder.WithExecOptions.
+func (r Runtime) DefaultExecOptions() ExecOptions {
+	return r.defaultOptions
+}
+
+// options returns the runtime default options, overridden by opts (see ExecOptions).
+func (r Runtime) options(opts *ExecOptions) *ExecOptions {
+	return mergeExecOptions(r.defaultOptions, opts)
+}
+
+// enter acquires the runtime for an exclusive call, and returns its pointer.
+// It blocks until the calls entered before it exit, and fails if the runtime is freed.
+func (r Runtime) enter(op Op) (unsafe.Pointer, error) {
+	return r.enterContext(context.Background(), op)
+}
+
+// enterContext is like enter, but it fails once ctx is done.
+// The ctx is the request context of the call, until it exits (see RequestContext).
+func (r Runtime) enterContext(ctx context.Context, op Op) (unsafe.Pointer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, wrapError(op, ErrCanceled, err)
+	}
+
+	p, err := r.h.acquire(op)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case r.lock <- struct{}{}:
+	case <-ctx.Done():
+		r.h.release()
+		return nil, wrapError(op, ErrCanceled, ctx.Err())
+	}
+
+	// The lock might have been available while ctx was already done.
+	if err := ctx.Err(); err != nil {
+		r.exit()
+		return nil, wrapError(op, ErrCanceled, err)
+	}
+
+	if host, ok := lookupHostHandle(r.host); ok {
+		host.setRequestContext(ctx)
+	}
+
+	return p, nil
+}
+
+// exit releases the runtime acquired by enter.
+func (r Runtime) exit() {
+	if host, ok := lookupHostHandle(r.host); ok {
+		host.setRequestContext(nil)
+	}
+
+	<-r.lock
+	r.h.release()
+}
+
+// takeImportPanic returns the panic recovered from an import function
+// during the last transaction, if any, and clears it.
+func (r Runtime) takeImportPanic() error {
+	host, ok := lookupHostHandle(r.host)
+	if !ok {
+		return nil
+	}
+
+	if err := host.takeImportPanic(); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 type RuntimeBuilder struct {
-	imports    unsafe.Pointer
-	memKV      unsafe.Pointer
+	imports    *handle
+	memKV      *handle
+	memKVLock  chan struct{}
 	diskKVPath string
-	host       unsafe.Pointer
+	host       interface{}
+	hasHost    bool
+
+	execOptions ExecOptions
 }
 
 func NewRuntimeBuilder() RuntimeBuilder {
@@ -26,12 +135,12 @@ func NewRuntimeBuilder() RuntimeBuilder {
 }
 
 func (rb RuntimeBuilder) WithImports(imports Imports) RuntimeBuilder {
-	rb.imports = imports.p
+	rb.imports = imports.h
 	return rb
 }
 
 func (rb RuntimeBuilder) WithMemKVStore(kv MemKVStore) RuntimeBuilder {
-	rb.memKV = kv.p
+	rb.memKV, rb.memKVLock = kv.h, kv.lock
 	return rb
 }
 
@@ -40,26 +149,102 @@ func (rb RuntimeBuilder) WithDiskKV(path string) RuntimeBuilder {
 	return rb
 }
 
+// WithHost sets a host pointer, which import functions can get back via InstanceContextHostGet.
+// The pointer itself is never passed to SVM.
+//
+// Deprecated: use WithHostValue instead.
 func (rb RuntimeBuilder) WithHost(p unsafe.Pointer) RuntimeBuilder {
-	rb.host = p
+	rb.host, rb.hasHost = p, true
+	return rb
+}
+
+// WithHostValue sets a host value, which import functions can get back via HostFromContext.
+// The value is kept on the Go side, and SVM is given only an opaque handle to it.
+func (rb RuntimeBuilder) WithHostValue(v interface{}) RuntimeBuilder {
+	rb.host, rb.hasHost = v, true
+	return rb
+}
+
+// WithExecOptions sets the default options of the runtime transaction calls,
+// which the options given to them override (see ExecOptions).
+func (rb RuntimeBuilder) WithExecOptions(opts ExecOptions) RuntimeBuilder {
+	rb.execOptions = opts
 	return rb
 }
 
+// Build creates the runtime.
+// When a disk kv path was set via WithDiskKV, the runtime is backed by
+// the disk kv-store located at that path, and its state survives the
+// runtime being freed. Otherwise, it is backed by the in-memory kv-store
+// set via WithMemKVStore.
+// The runtime holds its Imports and MemKVStore, so they are not destroyed
+// before it is, even if they are freed first.
+// The runtimes built with the same MemKVStore don't run concurrently,
+// since it isn't safe for concurrent use: their calls are serialized.
 func (rb RuntimeBuilder) Build() (Runtime, error) {
+	if rb.diskKVPath != "" && rb.memKV != nil {
+		return Runtime{}, newError(OpCreateRuntime, ErrValidation, "both memory kv-store and disk kv path were set")
+	}
+
+	var deps []*handle
+	releaseDeps := func() {
+		for _, dep := range deps {
+			dep.release()
+		}
+	}
+	acquireDep := func(h *handle) (unsafe.Pointer, error) {
+		if h == nil {
+			return nil, nil
+		}
+
+		p, err := h.acquire(OpCreateRuntime)
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, h)
+		return p, nil
+	}
+
+	imports, err := acquireDep(rb.imports)
+	if err != nil {
+		return Runtime{}, fmt.Errorf("failed to create runtime: %w", err)
+	}
+	memKV, err := acquireDep(rb.memKV)
+	if err != nil {
+		releaseDeps()
+		return Runtime{}, fmt.Errorf("failed to create runtime: %w", err)
+	}
+
 	var p unsafe.Pointer
+	host := newHostHandle(&hostEntry{value: rb.host, hasValue: rb.hasHost})
 
-	if err := cSvmMemoryRuntimeCreate(
-		&p,
-		rb.memKV,
-		rb.host,
-		rb.imports,
-	); err != nil {
-		return Runtime{}, fmt.Errorf("failed to create runtime: %v", err)
+	if rb.diskKVPath != "" {
+		err = cSvmRuntimeCreate(&p, rb.diskKVPath, host, imports)
+	} else {
+		err = cSvmMemoryRuntimeCreate(&p, memKV, host, imports)
+	}
+	if err != nil {
+		releaseHostHandle(host)
+		releaseDeps()
+		return Runtime{}, fmt.Errorf("failed to create runtime: %w", err)
 	}
 
-	return Runtime{p}, nil
-}
+	lock := rb.memKVLock
+	if lock == nil {
+		lock = make(chan struct{}, 1)
+	}
 
-func InstanceContextHostGet(ctx unsafe.Pointer) unsafe.Pointer {
-	return cSvmInstanceContextHostGet(ctx)
+	return Runtime{
+		h: newHandle("runtime", p, func(p unsafe.Pointer) {
+			cSvmRuntimeDestroy(p)
+			releaseHostHandle(host)
+			releaseDeps()
+		}),
+		host:           host,
+		lock:           lock,
+		defaultOptions: rb.execOptions,
+		memKV:          memKV,
+		diskKVPath:     rb.diskKVPath,
+		imports:        imports,
+	}, nil
 }
@@ -0,0 +1,283 @@
+package svm
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+// The storage template exports (in that order):
+// `storage_inc(i32)` and `storage_get() i32`, both operating on var #0.
+const (
+	storageIncFuncIndex uint16 = 0
+	storageGetFuncIndex uint16 = 1
+)
+
+func newTestImports(req *require.Assertions) Imports {
+	imports, err := NewImportsBuilder().Build()
+	req.NoError(err)
+	return imports
+}
+
+func deployTestTemplate(req *require.Assertions, runtime Runtime) Address {
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	req.NoError(err)
+
+	appTemplate, err := EncodeAppTemplate(0, "storage", code, DataLayout{4})
+	req.NoError(err)
+	req.NoError(ValidateTemplate(runtime, appTemplate))
+
+	res, err := DeployTemplate(runtime, appTemplate, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+
+	return res.TemplateAddr
+}
+
+func spawnTestApp(req *require.Assertions, runtime Runtime, templateAddr Address, initial int32) *SpawnAppResult {
+	spawnApp, err := EncodeSpawnApp(0, templateAddr, storageIncFuncIndex, nil, Values{I32(initial)})
+	req.NoError(err)
+	req.NoError(ValidateApp(runtime, spawnApp))
+
+	res, err := SpawnApp(runtime, spawnApp, Address{}, emptyHostCtx(), false, 0)
+	req.NoError(err)
+	req.True(res.Success, "spawn-app failed: %v", res.Err)
+
+	return res
+}
+
+func execTestApp(req *require.Assertions, runtime Runtime, appAddr Address, funcIndex uint16, args Values, appState []byte) *ExecAppResult {
+	appTx, err := EncodeAppTx(0, appAddr, funcIndex, nil, args)
+	req.NoError(err)
+
+	_, err = ValidateAppTx(runtime, appTx)
+	req.NoError(err)
+
+	res, err := ExecApp(runtime, appTx, appState, emptyHostCtx(), false, 0)
+	req.NoError(err)
+	req.True(res.Success, "exec-app failed: %v", res.Err)
+
+	return res
+}
+
+func TestRuntimeBuilder_Build_MemAndDiskKV(t *testing.T) {
+	req := require.New(t)
+
+	kv, err := NewMemKVStore()
+	req.NoError(err)
+	defer kv.Free()
+
+	_, err = NewRuntimeBuilder().
+		WithMemKVStore(kv).
+		WithDiskKV("some/path").
+		Build()
+	req.True(errors.Is(err, ErrValidation))
+	req.EqualError(err, "svm error: create runtime: validation failed: both memory kv-store and disk kv path were set")
+}
+
+func TestRuntime_DiskKV(t *testing.T) {
+	req := require.New(t)
+
+	path, err := ioutil.TempDir("", "go-svm-disk-kv")
+	req.NoError(err)
+	defer os.RemoveAll(path)
+
+	imports := newTestImports(req)
+	defer imports.Free()
+
+	runtime, err := NewRuntimeBuilder().
+		WithImports(imports).
+		WithDiskKV(path).
+		Build()
+	req.NoError(err)
+
+	templateAddr := deployTestTemplate(req, runtime)
+	spawnAppResult := spawnTestApp(req, runtime, templateAddr, 5)
+	execAppResult := execTestApp(req, runtime, spawnAppResult.AppAddr, storageIncFuncIndex, Values{I32(7)}, spawnAppResult.InitialState)
+	runtime.Free()
+
+	// Reopen the same directory, and run against the state written by the previous runtime.
+	runtime, err = NewRuntimeBuilder().
+		WithImports(imports).
+		WithDiskKV(path).
+		Build()
+	req.NoError(err)
+	defer runtime.Free()
+
+	execAppResult = execTestApp(req, runtime, spawnAppResult.AppAddr, storageGetFuncIndex, nil, execAppResult.NewState)
+	req.Equal(Values{I32(12)}, execAppResult.Returns)
+
+	// The app can keep being executed by the reopened runtime.
+	execAppResult = execTestApp(req, runtime, spawnAppResult.AppAddr, storageIncFuncIndex, Values{I32(1)}, execAppResult.NewState)
+	execAppResult = execTestApp(req, runtime, spawnAppResult.AppAddr, storageGetFuncIndex, nil, execAppResult.NewState)
+	req.Equal(Values{I32(13)}, execAppResult.Returns)
+}
+
+// newFakeRuntime returns a runtime whose handle isn't backed by SVM,
+// for testing the handling of runtimes without calling into SVM.
+func newFakeRuntime(destroyed *int32) Runtime {
+	var v byte
+	return Runtime{
+		h:    newHandle("runtime", unsafe.Pointer(&v), func(p unsafe.Pointer) { atomic.AddInt32(destroyed, 1) }),
+		lock: make(chan struct{}, 1),
+	}
+}
+
+func TestRuntime_Enter_Serialized(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+
+	var active, maxActive int32
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_, err := runtime.enter(OpExec)
+				if err != nil {
+					panic(err)
+				}
+
+				n := atomic.AddInt32(&active, 1)
+				if n > atomic.LoadInt32(&maxActive) {
+					atomic.StoreInt32(&maxActive, n)
+				}
+				atomic.AddInt32(&active, -1)
+
+				runtime.exit()
+			}
+		}()
+	}
+	wg.Wait()
+
+	req.Equal(int32(1), maxActive)
+
+	runtime.Free()
+	req.Equal(int32(1), destroyed)
+	_, err := runtime.enter(OpExec)
+	req.True(errors.Is(err, ErrFreed))
+}
+
+func TestRuntime_ConcurrentCommands(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	runConcurrentCommands(req, runtime)
+}
+
+func TestRuntime_SharedMemKVStore_Serialized(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	kv := MemKVStore{lock: make(chan struct{}, 1)}
+	rb := NewRuntimeBuilder().WithMemKVStore(kv)
+
+	// The runtimes built with the same MemKVStore share its lock.
+	a, b := newFakeRuntime(&destroyed), newFakeRuntime(&destroyed)
+	a.lock, b.lock = rb.memKVLock, rb.memKVLock
+
+	_, err := a.enter(OpExec)
+	req.NoError(err)
+
+	entered := make(chan struct{})
+	go func() {
+		if _, err := b.enter(OpExec); err != nil {
+			panic(err)
+		}
+		close(entered)
+		b.exit()
+	}()
+
+	select {
+	case <-entered:
+		req.Fail("entered a runtime sharing the kv-store of a busy one")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	a.exit()
+	<-entered
+}
+
+// runConcurrentCommands deploys, spawns and executes apps from several goroutines at once,
+// all using the same runtime.
+func runConcurrentCommands(req *require.Assertions, runtime Runtime) {
+	const goroutines = 8
+	const iterations = 10
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < iterations; j++ {
+				if err := runCommands(runtime, int32(i*iterations+j)); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		req.NoError(err)
+	}
+}
+
+func runCommands(runtime Runtime, initial int32) error {
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	if err != nil {
+		return err
+	}
+	appTemplate, err := EncodeAppTemplate(0, "storage", code, DataLayout{4})
+	if err != nil {
+		return err
+	}
+	deployResult, err := DeployTemplate(runtime, appTemplate, Address{}, emptyHostCtx(), false, 0)
+	if err != nil {
+		return err
+	}
+
+	spawnApp, err := EncodeSpawnApp(0, deployResult.TemplateAddr, storageIncFuncIndex, nil, Values{I32(initial)})
+	if err != nil {
+		return err
+	}
+	spawnResult, err := SpawnApp(runtime, spawnApp, Address{}, emptyHostCtx(), false, 0)
+	if err != nil {
+		return err
+	}
+	if !spawnResult.Success {
+		return spawnResult.Err
+	}
+
+	appTx, err := EncodeAppTx(0, spawnResult.AppAddr, storageGetFuncIndex, nil, nil)
+	if err != nil {
+		return err
+	}
+	execResult, err := ExecApp(runtime, appTx, spawnResult.InitialState, emptyHostCtx(), false, 0)
+	if err != nil {
+		return err
+	}
+	if !execResult.Success {
+		return execResult.Err
+	}
+	if len(execResult.Returns) != 1 || execResult.Returns[0] != I32(initial) {
+		return fmt.Errorf("unexpected returns; expected: %v, given: %v", Values{I32(initial)}, execResult.Returns)
+	}
+
+	return nil
+}
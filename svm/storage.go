@@ -0,0 +1,90 @@
+package svm
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// VarValue is an app storage var, along with its decoded value.
+type VarValue struct {
+	Var Var
+
+	// The decoded value; an uint32, uint64, bool, Address or []byte, depending on the var type.
+	Value interface{}
+}
+
+// String helps VarValue to implement the Stringer interface.
+func (v VarValue) String() string {
+	if b, ok := v.Value.([]byte); ok {
+		return fmt.Sprintf("%v = %x", v.Var, b)
+	}
+	if addr, ok := v.Value.(Address); ok {
+		return fmt.Sprintf("%v = %x", v.Var, addr[:])
+	}
+
+	return fmt.Sprintf("%v = %v", v.Var, v.Value)
+}
+
+// ReadVar returns the raw value of the var of the given ID, in the storage of the app at the given
+// state (e.g. a SpawnAppResult.InitialState or an ExecAppResult.NewState), where layout is the data
+// layout of the app template. The runtime must be backed by a MemKVStore, and it must be built with
+// the `svmdep` build tag (see ErrUnsupported). Reading the storage doesn't change the kv-store state.
+func (r Runtime) ReadVar(appAddr Address, state []byte, layout Vars, varID uint32) ([]byte, error) {
+	p, err := r.enterStorage(OpReadStorage)
+	if err != nil {
+		return nil, err
+	}
+	defer r.exit()
+
+	return r.readVar(p, appAddr, state, layout.DataLayout(), varID)
+}
+
+// DumpStorage returns the values of all the vars in the storage of the app at the given state,
+// decoded according to their types in layout, which is the data layout of the app template.
+// See ReadVar for the details.
+func (r Runtime) DumpStorage(appAddr Address, state []byte, layout Vars) ([]VarValue, error) {
+	p, err := r.enterStorage(OpReadStorage)
+	if err != nil {
+		return nil, err
+	}
+	defer r.exit()
+
+	dataLayout := layout.DataLayout()
+	values := make([]VarValue, len(layout))
+	for i, v := range layout {
+		raw, err := r.readVar(p, appAddr, state, dataLayout, v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := v.Decode(raw)
+		if err != nil {
+			return nil, newError(OpReadStorage, ErrRuntime, err.Error())
+		}
+		values[i] = VarValue{Var: v, Value: value}
+	}
+
+	return values, nil
+}
+
+// enterStorage enters the runtime for accessing its kv-store directly, which must be a MemKVStore,
+// since a disk kv-store can't be opened again while the runtime uses it.
+// The runtime is entered even though it isn't called, since the kv-store accesses rewind it,
+// which mustn't interleave with a transaction.
+func (r Runtime) enterStorage(op Op) (unsafe.Pointer, error) {
+	if r.memKV == nil {
+		return nil, newError(op, ErrValidation, "the runtime isn't backed by a memory kv-store")
+	}
+
+	return r.enter(op)
+}
+
+// readVar reads a var out of the runtime kv-store. The runtime must be entered.
+func (r Runtime) readVar(p unsafe.Pointer, appAddr Address, state []byte, dataLayout DataLayout, varID uint32) ([]byte, error) {
+	if int64(varID) >= int64(len(dataLayout)) {
+		return nil, newError(OpReadStorage, ErrValidation,
+			fmt.Sprintf("var #%v is out of the data layout; num vars: %v", varID, len(dataLayout)))
+	}
+
+	return cSvmDepReadVar(OpReadStorage, r.memKV, appAddr, state, dataLayout, varID)
+}
@@ -0,0 +1,52 @@
+//go:build svmdep
+// +build svmdep
+
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+)
+
+func TestRuntime_ReadVar(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	req.NoError(err)
+
+	vars, err := NewDataLayoutBuilder().U32("counter").Build()
+	req.NoError(err)
+
+	template, err := NewClient(runtime).Deploy(code, vars.DataLayout(), "storage")
+	req.NoError(err)
+	app, err := template.SpawnByName("storage_inc", I32(5))
+	req.NoError(err)
+	initialState := app.State()
+	_, err = app.CallByName("storage_inc", I32(3))
+	req.NoError(err)
+
+	// The var encoding isn't specified by SVM, so the decoded values are checked
+	// against the ones returned by the template getter, which reads them via `svm.get32`.
+	res, err := app.CallByName("storage_get")
+	req.NoError(err)
+	req.Equal(Values{I32(8)}, res.Returns)
+
+	value, err := runtime.ReadVar(app.Addr, app.State(), vars, 0)
+	req.NoError(err)
+	decoded, err := vars[0].Decode(value)
+	req.NoError(err)
+	req.Equal(uint32(8), decoded)
+
+	// Older states can be read as well.
+	values, err := runtime.DumpStorage(app.Addr, initialState, vars)
+	req.NoError(err)
+	req.Equal([]VarValue{{Var: vars[0], Value: uint32(5)}}, values)
+
+	_, err = runtime.ReadVar(app.Addr, app.State(), vars, 1)
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+}
@@ -0,0 +1,41 @@
+//go:build svmtest && svmdep
+// +build svmtest,svmdep
+
+package svm
+
+import "fmt"
+
+// WriteVar writes the raw value of the var of the given ID in the storage of the app,
+// on top of the given state, and returns the resulting state, which can then be passed
+// to ExecApp. layout is the data layout of the app template, and the value size must
+// match the var size. The runtime must be backed by a MemKVStore.
+//
+// It is meant for seeding the storage of apps in tests, without going through
+// transactions, and is only built with both the `svmtest` and `svmdep` build tags:
+//
+//	go test -tags "svmtest svmdep" ./...
+func (r Runtime) WriteVar(appAddr Address, state []byte, layout Vars, varID uint32, value []byte) ([]byte, error) {
+	if r.memKV == nil {
+		return nil, newError(OpWriteStorage, ErrValidation, "the runtime isn't backed by a memory kv-store")
+	}
+
+	dataLayout := layout.DataLayout()
+
+	if int64(varID) >= int64(len(dataLayout)) {
+		return nil, newError(OpWriteStorage, ErrValidation,
+			fmt.Sprintf("var #%v is out of the data layout; num vars: %v", varID, len(dataLayout)))
+	}
+	if uint32(len(value)) != dataLayout[varID] {
+		return nil, newError(OpWriteStorage, ErrValidation,
+			fmt.Sprintf("var #%v size is %v, but the value has %v bytes", varID, dataLayout[varID], len(value)))
+	}
+
+	// The runtime is entered even though the kv-store is used directly,
+	// since the write rewinds it, which mustn't interleave with a transaction.
+	if _, err := r.enter(OpWriteStorage); err != nil {
+		return nil, err
+	}
+	defer r.exit()
+
+	return cSvmDepWriteVar(r.memKV, appAddr, state, dataLayout, varID, value)
+}
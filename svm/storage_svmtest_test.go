@@ -0,0 +1,54 @@
+//go:build svmtest && svmdep
+// +build svmtest,svmdep
+
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+)
+
+func TestRuntime_WriteVar(t *testing.T) {
+	req := require.New(t)
+
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	req.NoError(err)
+
+	vars, err := NewDataLayoutBuilder().U32("counter").Build()
+	req.NoError(err)
+
+	template, err := NewClient(runtime).Deploy(code, vars.DataLayout(), "storage")
+	req.NoError(err)
+	app, err := template.SpawnByName("storage_inc", I32(5))
+	req.NoError(err)
+
+	// Seed the counter, and then run a transaction on top of the seeded state.
+	raw, err := vars[0].Encode(uint32(100))
+	req.NoError(err)
+	seeded, err := runtime.WriteVar(app.Addr, app.State(), vars, 0, raw)
+	req.NoError(err)
+
+	appTx, err := template.ABI.EncodeAppTx(0, app.Addr, "storage_get", nil, nil)
+	req.NoError(err)
+	res, err := ExecApp(runtime, appTx, seeded, emptyHostCtx(), false, 0)
+	req.NoError(err)
+	req.Equal(Values{I32(100)}, res.Returns)
+
+	// The seeded value is read back as written, and the original state is left as is.
+	value, err := runtime.ReadVar(app.Addr, seeded, vars, 0)
+	req.NoError(err)
+	req.Equal(raw, value)
+	values, err := runtime.DumpStorage(app.Addr, app.State(), vars)
+	req.NoError(err)
+	req.Equal([]VarValue{{Var: vars[0], Value: uint32(5)}}, values)
+
+	_, err = runtime.WriteVar(app.Addr, app.State(), vars, 0, []byte{1})
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+	_, err = runtime.WriteVar(app.Addr, app.State(), vars, 1, raw)
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+}
@@ -0,0 +1,39 @@
+package svm
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRuntime_ReadVar_NoMemKV(t *testing.T) {
+	req := require.New(t)
+
+	var destroyed int32
+	runtime := newFakeRuntime(&destroyed)
+	defer runtime.Free()
+
+	vars, err := NewDataLayoutBuilder().U32("counter").Build()
+	req.NoError(err)
+
+	_, err = runtime.ReadVar(Address{}, nil, vars, 0)
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+	req.EqualError(err, "svm error: read storage: validation failed: the runtime isn't backed by a memory kv-store")
+
+	// A disk kv-store can't be read.
+	runtime.diskKVPath = "kv"
+	_, err = runtime.DumpStorage(Address{}, nil, vars)
+	req.True(errors.Is(err, ErrValidation), "unexpected error: %v", err)
+}
+
+func TestVarValue_String(t *testing.T) {
+	req := require.New(t)
+
+	vars, err := NewDataLayoutBuilder().U32("counter").Address("owner").Bytes("hash", 2).Build()
+	req.NoError(err)
+
+	req.Equal("#0 counter: u32 = 7", VarValue{Var: vars[0], Value: uint32(7)}.String())
+	req.Equal("#1 owner: address = 0102000000000000000000000000000000000000",
+		VarValue{Var: vars[1], Value: bytesToAddress([]byte{1, 2})}.String())
+	req.Equal("#2 hash: bytes[2] = abcd", VarValue{Var: vars[2], Value: []byte{0xab, 0xcd}}.String())
+}
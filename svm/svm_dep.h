@@ -0,0 +1,80 @@
+#ifndef SVM_DEP_H
+#define SVM_DEP_H
+
+/**
+ * The C functions of the `svm-dep` shim (`libsvm_dep`), which complement the SVM C API.
+ * Unlike `svm.h`, this header is hand-written, and must be kept in sync with `svm-dep/src/lib.rs`.
+ */
+
+#include "./svm.h"
+
+/**
+ * Reads the raw value of an app storage var, at the given app state, out of an
+ * in-memory kv-store created via `svm_memory_kv_create`.
+ * The `data_layout` is the app template data layout, encoded as its vars sizes.
+ * The kv-store head is left as is.
+ *
+ * The returned `value` must be destroyed via `svm_byte_array_destroy`.
+ */
+svm_result_t svm_dep_memory_read_var(svm_byte_array *value,
+                                     const void *kv,
+                                     svm_byte_array app_addr,
+                                     svm_byte_array state,
+                                     svm_byte_array data_layout,
+                                     uint32_t var_id,
+                                     svm_byte_array *error);
+
+/**
+ * Writes the raw value of an app storage var, on top of the given app state, into an
+ * in-memory kv-store created via `svm_memory_kv_create`, and returns the resulting state.
+ * The `value` length must be the var size. The kv-store head is left as is.
+ * It is meant for setting up tests.
+ *
+ * The returned `new_state` must be destroyed via `svm_byte_array_destroy`.
+ */
+svm_result_t svm_dep_memory_write_var(svm_byte_array *new_state,
+                                      const void *kv,
+                                      svm_byte_array app_addr,
+                                      svm_byte_array state,
+                                      svm_byte_array data_layout,
+                                      uint32_t var_id,
+                                      svm_byte_array value,
+                                      svm_byte_array *error);
+
+/**
+ * Creates a kv-store overlay over an in-memory kv-store created via `svm_memory_kv_create`,
+ * for running a query. The kv-store to pass to `svm_memory_runtime_create` is returned by
+ * `svm_dep_overlay_kv_store`. Its writes never reach the underlying kv-store.
+ *
+ * The overlay must be destroyed via `svm_dep_overlay_kv_destroy`, after the runtime using it,
+ * which drops its writes, and restores the head of the underlying kv-store.
+ */
+svm_result_t svm_dep_overlay_kv_create(void **overlay, const void *kv);
+
+/**
+ * Returns the kv-store of an overlay, to pass to `svm_memory_runtime_create`.
+ */
+const void *svm_dep_overlay_kv_store(const void *overlay);
+
+/**
+ * Destroys an overlay created via `svm_dep_overlay_kv_create`.
+ */
+void svm_dep_overlay_kv_destroy(void *overlay);
+
+/**
+ * Returns the current head of an in-memory kv-store created via `svm_memory_kv_create`.
+ * It is meant for tests.
+ *
+ * The returned `head` must be destroyed via `svm_byte_array_destroy`.
+ */
+void svm_dep_memory_kv_head(svm_byte_array *head, const void *kv);
+
+/**
+ * Traps the runtime from within an import function, which aborts the running transaction
+ * like a trapping wasm instruction does. The `ctx` is the one the import function was called with.
+ * It never returns: it unwinds to the runtime, through the frames of the import function
+ * and the wasm code, which must not include Go frames.
+ */
+void svm_dep_import_trap(void *ctx);
+
+#endif /* SVM_DEP_H */
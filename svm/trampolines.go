@@ -0,0 +1,3097 @@
+// Code generated by gen_trampolines; DO NOT EDIT.
+
+package svm
+
+// #include <stdint.h>
+//
+// extern int64_t svm_import_dispatch(void *ctx, int slot, int64_t *args, int nargs);
+//
+// static void svm_trampoline_v_v_0(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 0, args, 0); }
+// static void svm_trampoline_v_v_1(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 1, args, 0); }
+// static void svm_trampoline_v_v_2(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 2, args, 0); }
+// static void svm_trampoline_v_v_3(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 3, args, 0); }
+// static void svm_trampoline_v_v_4(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 4, args, 0); }
+// static void svm_trampoline_v_v_5(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 5, args, 0); }
+// static void svm_trampoline_v_v_6(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 6, args, 0); }
+// static void svm_trampoline_v_v_7(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 7, args, 0); }
+// static void svm_trampoline_v_v_8(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 8, args, 0); }
+// static void svm_trampoline_v_v_9(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 9, args, 0); }
+// static void svm_trampoline_v_v_10(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 10, args, 0); }
+// static void svm_trampoline_v_v_11(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 11, args, 0); }
+// static void svm_trampoline_v_v_12(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 12, args, 0); }
+// static void svm_trampoline_v_v_13(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 13, args, 0); }
+// static void svm_trampoline_v_v_14(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 14, args, 0); }
+// static void svm_trampoline_v_v_15(void *ctx) { int64_t *args = 0; svm_import_dispatch(ctx, 15, args, 0); }
+// static int32_t svm_trampoline_v_i_0(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 16, args, 0); }
+// static int32_t svm_trampoline_v_i_1(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 17, args, 0); }
+// static int32_t svm_trampoline_v_i_2(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 18, args, 0); }
+// static int32_t svm_trampoline_v_i_3(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 19, args, 0); }
+// static int32_t svm_trampoline_v_i_4(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 20, args, 0); }
+// static int32_t svm_trampoline_v_i_5(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 21, args, 0); }
+// static int32_t svm_trampoline_v_i_6(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 22, args, 0); }
+// static int32_t svm_trampoline_v_i_7(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 23, args, 0); }
+// static int32_t svm_trampoline_v_i_8(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 24, args, 0); }
+// static int32_t svm_trampoline_v_i_9(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 25, args, 0); }
+// static int32_t svm_trampoline_v_i_10(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 26, args, 0); }
+// static int32_t svm_trampoline_v_i_11(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 27, args, 0); }
+// static int32_t svm_trampoline_v_i_12(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 28, args, 0); }
+// static int32_t svm_trampoline_v_i_13(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 29, args, 0); }
+// static int32_t svm_trampoline_v_i_14(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 30, args, 0); }
+// static int32_t svm_trampoline_v_i_15(void *ctx) { int64_t *args = 0; return (int32_t)svm_import_dispatch(ctx, 31, args, 0); }
+// static int64_t svm_trampoline_v_l_0(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 32, args, 0); }
+// static int64_t svm_trampoline_v_l_1(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 33, args, 0); }
+// static int64_t svm_trampoline_v_l_2(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 34, args, 0); }
+// static int64_t svm_trampoline_v_l_3(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 35, args, 0); }
+// static int64_t svm_trampoline_v_l_4(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 36, args, 0); }
+// static int64_t svm_trampoline_v_l_5(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 37, args, 0); }
+// static int64_t svm_trampoline_v_l_6(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 38, args, 0); }
+// static int64_t svm_trampoline_v_l_7(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 39, args, 0); }
+// static int64_t svm_trampoline_v_l_8(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 40, args, 0); }
+// static int64_t svm_trampoline_v_l_9(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 41, args, 0); }
+// static int64_t svm_trampoline_v_l_10(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 42, args, 0); }
+// static int64_t svm_trampoline_v_l_11(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 43, args, 0); }
+// static int64_t svm_trampoline_v_l_12(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 44, args, 0); }
+// static int64_t svm_trampoline_v_l_13(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 45, args, 0); }
+// static int64_t svm_trampoline_v_l_14(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 46, args, 0); }
+// static int64_t svm_trampoline_v_l_15(void *ctx) { int64_t *args = 0; return (int64_t)svm_import_dispatch(ctx, 47, args, 0); }
+// static void svm_trampoline_i_v_0(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 48, args, 1); }
+// static void svm_trampoline_i_v_1(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 49, args, 1); }
+// static void svm_trampoline_i_v_2(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 50, args, 1); }
+// static void svm_trampoline_i_v_3(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 51, args, 1); }
+// static void svm_trampoline_i_v_4(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 52, args, 1); }
+// static void svm_trampoline_i_v_5(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 53, args, 1); }
+// static void svm_trampoline_i_v_6(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 54, args, 1); }
+// static void svm_trampoline_i_v_7(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 55, args, 1); }
+// static void svm_trampoline_i_v_8(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 56, args, 1); }
+// static void svm_trampoline_i_v_9(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 57, args, 1); }
+// static void svm_trampoline_i_v_10(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 58, args, 1); }
+// static void svm_trampoline_i_v_11(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 59, args, 1); }
+// static void svm_trampoline_i_v_12(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 60, args, 1); }
+// static void svm_trampoline_i_v_13(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 61, args, 1); }
+// static void svm_trampoline_i_v_14(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 62, args, 1); }
+// static void svm_trampoline_i_v_15(void *ctx, int32_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 63, args, 1); }
+// static int32_t svm_trampoline_i_i_0(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 64, args, 1); }
+// static int32_t svm_trampoline_i_i_1(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 65, args, 1); }
+// static int32_t svm_trampoline_i_i_2(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 66, args, 1); }
+// static int32_t svm_trampoline_i_i_3(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 67, args, 1); }
+// static int32_t svm_trampoline_i_i_4(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 68, args, 1); }
+// static int32_t svm_trampoline_i_i_5(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 69, args, 1); }
+// static int32_t svm_trampoline_i_i_6(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 70, args, 1); }
+// static int32_t svm_trampoline_i_i_7(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 71, args, 1); }
+// static int32_t svm_trampoline_i_i_8(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 72, args, 1); }
+// static int32_t svm_trampoline_i_i_9(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 73, args, 1); }
+// static int32_t svm_trampoline_i_i_10(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 74, args, 1); }
+// static int32_t svm_trampoline_i_i_11(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 75, args, 1); }
+// static int32_t svm_trampoline_i_i_12(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 76, args, 1); }
+// static int32_t svm_trampoline_i_i_13(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 77, args, 1); }
+// static int32_t svm_trampoline_i_i_14(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 78, args, 1); }
+// static int32_t svm_trampoline_i_i_15(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 79, args, 1); }
+// static int64_t svm_trampoline_i_l_0(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 80, args, 1); }
+// static int64_t svm_trampoline_i_l_1(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 81, args, 1); }
+// static int64_t svm_trampoline_i_l_2(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 82, args, 1); }
+// static int64_t svm_trampoline_i_l_3(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 83, args, 1); }
+// static int64_t svm_trampoline_i_l_4(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 84, args, 1); }
+// static int64_t svm_trampoline_i_l_5(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 85, args, 1); }
+// static int64_t svm_trampoline_i_l_6(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 86, args, 1); }
+// static int64_t svm_trampoline_i_l_7(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 87, args, 1); }
+// static int64_t svm_trampoline_i_l_8(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 88, args, 1); }
+// static int64_t svm_trampoline_i_l_9(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 89, args, 1); }
+// static int64_t svm_trampoline_i_l_10(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 90, args, 1); }
+// static int64_t svm_trampoline_i_l_11(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 91, args, 1); }
+// static int64_t svm_trampoline_i_l_12(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 92, args, 1); }
+// static int64_t svm_trampoline_i_l_13(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 93, args, 1); }
+// static int64_t svm_trampoline_i_l_14(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 94, args, 1); }
+// static int64_t svm_trampoline_i_l_15(void *ctx, int32_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 95, args, 1); }
+// static void svm_trampoline_ii_v_0(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 96, args, 2); }
+// static void svm_trampoline_ii_v_1(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 97, args, 2); }
+// static void svm_trampoline_ii_v_2(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 98, args, 2); }
+// static void svm_trampoline_ii_v_3(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 99, args, 2); }
+// static void svm_trampoline_ii_v_4(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 100, args, 2); }
+// static void svm_trampoline_ii_v_5(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 101, args, 2); }
+// static void svm_trampoline_ii_v_6(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 102, args, 2); }
+// static void svm_trampoline_ii_v_7(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 103, args, 2); }
+// static void svm_trampoline_ii_v_8(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 104, args, 2); }
+// static void svm_trampoline_ii_v_9(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 105, args, 2); }
+// static void svm_trampoline_ii_v_10(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 106, args, 2); }
+// static void svm_trampoline_ii_v_11(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 107, args, 2); }
+// static void svm_trampoline_ii_v_12(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 108, args, 2); }
+// static void svm_trampoline_ii_v_13(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 109, args, 2); }
+// static void svm_trampoline_ii_v_14(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 110, args, 2); }
+// static void svm_trampoline_ii_v_15(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 111, args, 2); }
+// static int32_t svm_trampoline_ii_i_0(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 112, args, 2); }
+// static int32_t svm_trampoline_ii_i_1(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 113, args, 2); }
+// static int32_t svm_trampoline_ii_i_2(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 114, args, 2); }
+// static int32_t svm_trampoline_ii_i_3(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 115, args, 2); }
+// static int32_t svm_trampoline_ii_i_4(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 116, args, 2); }
+// static int32_t svm_trampoline_ii_i_5(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 117, args, 2); }
+// static int32_t svm_trampoline_ii_i_6(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 118, args, 2); }
+// static int32_t svm_trampoline_ii_i_7(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 119, args, 2); }
+// static int32_t svm_trampoline_ii_i_8(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 120, args, 2); }
+// static int32_t svm_trampoline_ii_i_9(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 121, args, 2); }
+// static int32_t svm_trampoline_ii_i_10(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 122, args, 2); }
+// static int32_t svm_trampoline_ii_i_11(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 123, args, 2); }
+// static int32_t svm_trampoline_ii_i_12(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 124, args, 2); }
+// static int32_t svm_trampoline_ii_i_13(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 125, args, 2); }
+// static int32_t svm_trampoline_ii_i_14(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 126, args, 2); }
+// static int32_t svm_trampoline_ii_i_15(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 127, args, 2); }
+// static int64_t svm_trampoline_ii_l_0(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 128, args, 2); }
+// static int64_t svm_trampoline_ii_l_1(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 129, args, 2); }
+// static int64_t svm_trampoline_ii_l_2(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 130, args, 2); }
+// static int64_t svm_trampoline_ii_l_3(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 131, args, 2); }
+// static int64_t svm_trampoline_ii_l_4(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 132, args, 2); }
+// static int64_t svm_trampoline_ii_l_5(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 133, args, 2); }
+// static int64_t svm_trampoline_ii_l_6(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 134, args, 2); }
+// static int64_t svm_trampoline_ii_l_7(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 135, args, 2); }
+// static int64_t svm_trampoline_ii_l_8(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 136, args, 2); }
+// static int64_t svm_trampoline_ii_l_9(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 137, args, 2); }
+// static int64_t svm_trampoline_ii_l_10(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 138, args, 2); }
+// static int64_t svm_trampoline_ii_l_11(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 139, args, 2); }
+// static int64_t svm_trampoline_ii_l_12(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 140, args, 2); }
+// static int64_t svm_trampoline_ii_l_13(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 141, args, 2); }
+// static int64_t svm_trampoline_ii_l_14(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 142, args, 2); }
+// static int64_t svm_trampoline_ii_l_15(void *ctx, int32_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 143, args, 2); }
+// static void svm_trampoline_iii_v_0(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 144, args, 3); }
+// static void svm_trampoline_iii_v_1(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 145, args, 3); }
+// static void svm_trampoline_iii_v_2(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 146, args, 3); }
+// static void svm_trampoline_iii_v_3(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 147, args, 3); }
+// static void svm_trampoline_iii_v_4(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 148, args, 3); }
+// static void svm_trampoline_iii_v_5(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 149, args, 3); }
+// static void svm_trampoline_iii_v_6(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 150, args, 3); }
+// static void svm_trampoline_iii_v_7(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 151, args, 3); }
+// static void svm_trampoline_iii_v_8(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 152, args, 3); }
+// static void svm_trampoline_iii_v_9(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 153, args, 3); }
+// static void svm_trampoline_iii_v_10(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 154, args, 3); }
+// static void svm_trampoline_iii_v_11(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 155, args, 3); }
+// static void svm_trampoline_iii_v_12(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 156, args, 3); }
+// static void svm_trampoline_iii_v_13(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 157, args, 3); }
+// static void svm_trampoline_iii_v_14(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 158, args, 3); }
+// static void svm_trampoline_iii_v_15(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 159, args, 3); }
+// static int32_t svm_trampoline_iii_i_0(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 160, args, 3); }
+// static int32_t svm_trampoline_iii_i_1(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 161, args, 3); }
+// static int32_t svm_trampoline_iii_i_2(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 162, args, 3); }
+// static int32_t svm_trampoline_iii_i_3(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 163, args, 3); }
+// static int32_t svm_trampoline_iii_i_4(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 164, args, 3); }
+// static int32_t svm_trampoline_iii_i_5(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 165, args, 3); }
+// static int32_t svm_trampoline_iii_i_6(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 166, args, 3); }
+// static int32_t svm_trampoline_iii_i_7(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 167, args, 3); }
+// static int32_t svm_trampoline_iii_i_8(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 168, args, 3); }
+// static int32_t svm_trampoline_iii_i_9(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 169, args, 3); }
+// static int32_t svm_trampoline_iii_i_10(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 170, args, 3); }
+// static int32_t svm_trampoline_iii_i_11(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 171, args, 3); }
+// static int32_t svm_trampoline_iii_i_12(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 172, args, 3); }
+// static int32_t svm_trampoline_iii_i_13(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 173, args, 3); }
+// static int32_t svm_trampoline_iii_i_14(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 174, args, 3); }
+// static int32_t svm_trampoline_iii_i_15(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 175, args, 3); }
+// static int64_t svm_trampoline_iii_l_0(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 176, args, 3); }
+// static int64_t svm_trampoline_iii_l_1(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 177, args, 3); }
+// static int64_t svm_trampoline_iii_l_2(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 178, args, 3); }
+// static int64_t svm_trampoline_iii_l_3(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 179, args, 3); }
+// static int64_t svm_trampoline_iii_l_4(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 180, args, 3); }
+// static int64_t svm_trampoline_iii_l_5(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 181, args, 3); }
+// static int64_t svm_trampoline_iii_l_6(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 182, args, 3); }
+// static int64_t svm_trampoline_iii_l_7(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 183, args, 3); }
+// static int64_t svm_trampoline_iii_l_8(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 184, args, 3); }
+// static int64_t svm_trampoline_iii_l_9(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 185, args, 3); }
+// static int64_t svm_trampoline_iii_l_10(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 186, args, 3); }
+// static int64_t svm_trampoline_iii_l_11(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 187, args, 3); }
+// static int64_t svm_trampoline_iii_l_12(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 188, args, 3); }
+// static int64_t svm_trampoline_iii_l_13(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 189, args, 3); }
+// static int64_t svm_trampoline_iii_l_14(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 190, args, 3); }
+// static int64_t svm_trampoline_iii_l_15(void *ctx, int32_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 191, args, 3); }
+// static void svm_trampoline_iiii_v_0(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 192, args, 4); }
+// static void svm_trampoline_iiii_v_1(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 193, args, 4); }
+// static void svm_trampoline_iiii_v_2(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 194, args, 4); }
+// static void svm_trampoline_iiii_v_3(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 195, args, 4); }
+// static void svm_trampoline_iiii_v_4(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 196, args, 4); }
+// static void svm_trampoline_iiii_v_5(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 197, args, 4); }
+// static void svm_trampoline_iiii_v_6(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 198, args, 4); }
+// static void svm_trampoline_iiii_v_7(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 199, args, 4); }
+// static void svm_trampoline_iiii_v_8(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 200, args, 4); }
+// static void svm_trampoline_iiii_v_9(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 201, args, 4); }
+// static void svm_trampoline_iiii_v_10(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 202, args, 4); }
+// static void svm_trampoline_iiii_v_11(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 203, args, 4); }
+// static void svm_trampoline_iiii_v_12(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 204, args, 4); }
+// static void svm_trampoline_iiii_v_13(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 205, args, 4); }
+// static void svm_trampoline_iiii_v_14(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 206, args, 4); }
+// static void svm_trampoline_iiii_v_15(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 207, args, 4); }
+// static int32_t svm_trampoline_iiii_i_0(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 208, args, 4); }
+// static int32_t svm_trampoline_iiii_i_1(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 209, args, 4); }
+// static int32_t svm_trampoline_iiii_i_2(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 210, args, 4); }
+// static int32_t svm_trampoline_iiii_i_3(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 211, args, 4); }
+// static int32_t svm_trampoline_iiii_i_4(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 212, args, 4); }
+// static int32_t svm_trampoline_iiii_i_5(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 213, args, 4); }
+// static int32_t svm_trampoline_iiii_i_6(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 214, args, 4); }
+// static int32_t svm_trampoline_iiii_i_7(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 215, args, 4); }
+// static int32_t svm_trampoline_iiii_i_8(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 216, args, 4); }
+// static int32_t svm_trampoline_iiii_i_9(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 217, args, 4); }
+// static int32_t svm_trampoline_iiii_i_10(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 218, args, 4); }
+// static int32_t svm_trampoline_iiii_i_11(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 219, args, 4); }
+// static int32_t svm_trampoline_iiii_i_12(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 220, args, 4); }
+// static int32_t svm_trampoline_iiii_i_13(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 221, args, 4); }
+// static int32_t svm_trampoline_iiii_i_14(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 222, args, 4); }
+// static int32_t svm_trampoline_iiii_i_15(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 223, args, 4); }
+// static int64_t svm_trampoline_iiii_l_0(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 224, args, 4); }
+// static int64_t svm_trampoline_iiii_l_1(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 225, args, 4); }
+// static int64_t svm_trampoline_iiii_l_2(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 226, args, 4); }
+// static int64_t svm_trampoline_iiii_l_3(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 227, args, 4); }
+// static int64_t svm_trampoline_iiii_l_4(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 228, args, 4); }
+// static int64_t svm_trampoline_iiii_l_5(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 229, args, 4); }
+// static int64_t svm_trampoline_iiii_l_6(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 230, args, 4); }
+// static int64_t svm_trampoline_iiii_l_7(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 231, args, 4); }
+// static int64_t svm_trampoline_iiii_l_8(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 232, args, 4); }
+// static int64_t svm_trampoline_iiii_l_9(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 233, args, 4); }
+// static int64_t svm_trampoline_iiii_l_10(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 234, args, 4); }
+// static int64_t svm_trampoline_iiii_l_11(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 235, args, 4); }
+// static int64_t svm_trampoline_iiii_l_12(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 236, args, 4); }
+// static int64_t svm_trampoline_iiii_l_13(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 237, args, 4); }
+// static int64_t svm_trampoline_iiii_l_14(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 238, args, 4); }
+// static int64_t svm_trampoline_iiii_l_15(void *ctx, int32_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 239, args, 4); }
+// static void svm_trampoline_iiil_v_0(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 240, args, 4); }
+// static void svm_trampoline_iiil_v_1(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 241, args, 4); }
+// static void svm_trampoline_iiil_v_2(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 242, args, 4); }
+// static void svm_trampoline_iiil_v_3(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 243, args, 4); }
+// static void svm_trampoline_iiil_v_4(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 244, args, 4); }
+// static void svm_trampoline_iiil_v_5(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 245, args, 4); }
+// static void svm_trampoline_iiil_v_6(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 246, args, 4); }
+// static void svm_trampoline_iiil_v_7(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 247, args, 4); }
+// static void svm_trampoline_iiil_v_8(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 248, args, 4); }
+// static void svm_trampoline_iiil_v_9(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 249, args, 4); }
+// static void svm_trampoline_iiil_v_10(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 250, args, 4); }
+// static void svm_trampoline_iiil_v_11(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 251, args, 4); }
+// static void svm_trampoline_iiil_v_12(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 252, args, 4); }
+// static void svm_trampoline_iiil_v_13(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 253, args, 4); }
+// static void svm_trampoline_iiil_v_14(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 254, args, 4); }
+// static void svm_trampoline_iiil_v_15(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 255, args, 4); }
+// static int32_t svm_trampoline_iiil_i_0(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 256, args, 4); }
+// static int32_t svm_trampoline_iiil_i_1(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 257, args, 4); }
+// static int32_t svm_trampoline_iiil_i_2(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 258, args, 4); }
+// static int32_t svm_trampoline_iiil_i_3(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 259, args, 4); }
+// static int32_t svm_trampoline_iiil_i_4(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 260, args, 4); }
+// static int32_t svm_trampoline_iiil_i_5(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 261, args, 4); }
+// static int32_t svm_trampoline_iiil_i_6(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 262, args, 4); }
+// static int32_t svm_trampoline_iiil_i_7(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 263, args, 4); }
+// static int32_t svm_trampoline_iiil_i_8(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 264, args, 4); }
+// static int32_t svm_trampoline_iiil_i_9(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 265, args, 4); }
+// static int32_t svm_trampoline_iiil_i_10(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 266, args, 4); }
+// static int32_t svm_trampoline_iiil_i_11(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 267, args, 4); }
+// static int32_t svm_trampoline_iiil_i_12(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 268, args, 4); }
+// static int32_t svm_trampoline_iiil_i_13(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 269, args, 4); }
+// static int32_t svm_trampoline_iiil_i_14(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 270, args, 4); }
+// static int32_t svm_trampoline_iiil_i_15(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 271, args, 4); }
+// static int64_t svm_trampoline_iiil_l_0(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 272, args, 4); }
+// static int64_t svm_trampoline_iiil_l_1(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 273, args, 4); }
+// static int64_t svm_trampoline_iiil_l_2(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 274, args, 4); }
+// static int64_t svm_trampoline_iiil_l_3(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 275, args, 4); }
+// static int64_t svm_trampoline_iiil_l_4(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 276, args, 4); }
+// static int64_t svm_trampoline_iiil_l_5(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 277, args, 4); }
+// static int64_t svm_trampoline_iiil_l_6(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 278, args, 4); }
+// static int64_t svm_trampoline_iiil_l_7(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 279, args, 4); }
+// static int64_t svm_trampoline_iiil_l_8(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 280, args, 4); }
+// static int64_t svm_trampoline_iiil_l_9(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 281, args, 4); }
+// static int64_t svm_trampoline_iiil_l_10(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 282, args, 4); }
+// static int64_t svm_trampoline_iiil_l_11(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 283, args, 4); }
+// static int64_t svm_trampoline_iiil_l_12(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 284, args, 4); }
+// static int64_t svm_trampoline_iiil_l_13(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 285, args, 4); }
+// static int64_t svm_trampoline_iiil_l_14(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 286, args, 4); }
+// static int64_t svm_trampoline_iiil_l_15(void *ctx, int32_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 287, args, 4); }
+// static void svm_trampoline_iil_v_0(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 288, args, 3); }
+// static void svm_trampoline_iil_v_1(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 289, args, 3); }
+// static void svm_trampoline_iil_v_2(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 290, args, 3); }
+// static void svm_trampoline_iil_v_3(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 291, args, 3); }
+// static void svm_trampoline_iil_v_4(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 292, args, 3); }
+// static void svm_trampoline_iil_v_5(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 293, args, 3); }
+// static void svm_trampoline_iil_v_6(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 294, args, 3); }
+// static void svm_trampoline_iil_v_7(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 295, args, 3); }
+// static void svm_trampoline_iil_v_8(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 296, args, 3); }
+// static void svm_trampoline_iil_v_9(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 297, args, 3); }
+// static void svm_trampoline_iil_v_10(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 298, args, 3); }
+// static void svm_trampoline_iil_v_11(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 299, args, 3); }
+// static void svm_trampoline_iil_v_12(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 300, args, 3); }
+// static void svm_trampoline_iil_v_13(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 301, args, 3); }
+// static void svm_trampoline_iil_v_14(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 302, args, 3); }
+// static void svm_trampoline_iil_v_15(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 303, args, 3); }
+// static int32_t svm_trampoline_iil_i_0(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 304, args, 3); }
+// static int32_t svm_trampoline_iil_i_1(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 305, args, 3); }
+// static int32_t svm_trampoline_iil_i_2(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 306, args, 3); }
+// static int32_t svm_trampoline_iil_i_3(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 307, args, 3); }
+// static int32_t svm_trampoline_iil_i_4(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 308, args, 3); }
+// static int32_t svm_trampoline_iil_i_5(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 309, args, 3); }
+// static int32_t svm_trampoline_iil_i_6(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 310, args, 3); }
+// static int32_t svm_trampoline_iil_i_7(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 311, args, 3); }
+// static int32_t svm_trampoline_iil_i_8(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 312, args, 3); }
+// static int32_t svm_trampoline_iil_i_9(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 313, args, 3); }
+// static int32_t svm_trampoline_iil_i_10(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 314, args, 3); }
+// static int32_t svm_trampoline_iil_i_11(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 315, args, 3); }
+// static int32_t svm_trampoline_iil_i_12(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 316, args, 3); }
+// static int32_t svm_trampoline_iil_i_13(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 317, args, 3); }
+// static int32_t svm_trampoline_iil_i_14(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 318, args, 3); }
+// static int32_t svm_trampoline_iil_i_15(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 319, args, 3); }
+// static int64_t svm_trampoline_iil_l_0(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 320, args, 3); }
+// static int64_t svm_trampoline_iil_l_1(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 321, args, 3); }
+// static int64_t svm_trampoline_iil_l_2(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 322, args, 3); }
+// static int64_t svm_trampoline_iil_l_3(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 323, args, 3); }
+// static int64_t svm_trampoline_iil_l_4(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 324, args, 3); }
+// static int64_t svm_trampoline_iil_l_5(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 325, args, 3); }
+// static int64_t svm_trampoline_iil_l_6(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 326, args, 3); }
+// static int64_t svm_trampoline_iil_l_7(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 327, args, 3); }
+// static int64_t svm_trampoline_iil_l_8(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 328, args, 3); }
+// static int64_t svm_trampoline_iil_l_9(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 329, args, 3); }
+// static int64_t svm_trampoline_iil_l_10(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 330, args, 3); }
+// static int64_t svm_trampoline_iil_l_11(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 331, args, 3); }
+// static int64_t svm_trampoline_iil_l_12(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 332, args, 3); }
+// static int64_t svm_trampoline_iil_l_13(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 333, args, 3); }
+// static int64_t svm_trampoline_iil_l_14(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 334, args, 3); }
+// static int64_t svm_trampoline_iil_l_15(void *ctx, int32_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 335, args, 3); }
+// static void svm_trampoline_iili_v_0(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 336, args, 4); }
+// static void svm_trampoline_iili_v_1(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 337, args, 4); }
+// static void svm_trampoline_iili_v_2(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 338, args, 4); }
+// static void svm_trampoline_iili_v_3(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 339, args, 4); }
+// static void svm_trampoline_iili_v_4(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 340, args, 4); }
+// static void svm_trampoline_iili_v_5(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 341, args, 4); }
+// static void svm_trampoline_iili_v_6(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 342, args, 4); }
+// static void svm_trampoline_iili_v_7(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 343, args, 4); }
+// static void svm_trampoline_iili_v_8(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 344, args, 4); }
+// static void svm_trampoline_iili_v_9(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 345, args, 4); }
+// static void svm_trampoline_iili_v_10(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 346, args, 4); }
+// static void svm_trampoline_iili_v_11(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 347, args, 4); }
+// static void svm_trampoline_iili_v_12(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 348, args, 4); }
+// static void svm_trampoline_iili_v_13(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 349, args, 4); }
+// static void svm_trampoline_iili_v_14(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 350, args, 4); }
+// static void svm_trampoline_iili_v_15(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 351, args, 4); }
+// static int32_t svm_trampoline_iili_i_0(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 352, args, 4); }
+// static int32_t svm_trampoline_iili_i_1(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 353, args, 4); }
+// static int32_t svm_trampoline_iili_i_2(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 354, args, 4); }
+// static int32_t svm_trampoline_iili_i_3(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 355, args, 4); }
+// static int32_t svm_trampoline_iili_i_4(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 356, args, 4); }
+// static int32_t svm_trampoline_iili_i_5(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 357, args, 4); }
+// static int32_t svm_trampoline_iili_i_6(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 358, args, 4); }
+// static int32_t svm_trampoline_iili_i_7(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 359, args, 4); }
+// static int32_t svm_trampoline_iili_i_8(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 360, args, 4); }
+// static int32_t svm_trampoline_iili_i_9(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 361, args, 4); }
+// static int32_t svm_trampoline_iili_i_10(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 362, args, 4); }
+// static int32_t svm_trampoline_iili_i_11(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 363, args, 4); }
+// static int32_t svm_trampoline_iili_i_12(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 364, args, 4); }
+// static int32_t svm_trampoline_iili_i_13(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 365, args, 4); }
+// static int32_t svm_trampoline_iili_i_14(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 366, args, 4); }
+// static int32_t svm_trampoline_iili_i_15(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 367, args, 4); }
+// static int64_t svm_trampoline_iili_l_0(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 368, args, 4); }
+// static int64_t svm_trampoline_iili_l_1(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 369, args, 4); }
+// static int64_t svm_trampoline_iili_l_2(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 370, args, 4); }
+// static int64_t svm_trampoline_iili_l_3(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 371, args, 4); }
+// static int64_t svm_trampoline_iili_l_4(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 372, args, 4); }
+// static int64_t svm_trampoline_iili_l_5(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 373, args, 4); }
+// static int64_t svm_trampoline_iili_l_6(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 374, args, 4); }
+// static int64_t svm_trampoline_iili_l_7(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 375, args, 4); }
+// static int64_t svm_trampoline_iili_l_8(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 376, args, 4); }
+// static int64_t svm_trampoline_iili_l_9(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 377, args, 4); }
+// static int64_t svm_trampoline_iili_l_10(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 378, args, 4); }
+// static int64_t svm_trampoline_iili_l_11(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 379, args, 4); }
+// static int64_t svm_trampoline_iili_l_12(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 380, args, 4); }
+// static int64_t svm_trampoline_iili_l_13(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 381, args, 4); }
+// static int64_t svm_trampoline_iili_l_14(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 382, args, 4); }
+// static int64_t svm_trampoline_iili_l_15(void *ctx, int32_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 383, args, 4); }
+// static void svm_trampoline_iill_v_0(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 384, args, 4); }
+// static void svm_trampoline_iill_v_1(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 385, args, 4); }
+// static void svm_trampoline_iill_v_2(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 386, args, 4); }
+// static void svm_trampoline_iill_v_3(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 387, args, 4); }
+// static void svm_trampoline_iill_v_4(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 388, args, 4); }
+// static void svm_trampoline_iill_v_5(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 389, args, 4); }
+// static void svm_trampoline_iill_v_6(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 390, args, 4); }
+// static void svm_trampoline_iill_v_7(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 391, args, 4); }
+// static void svm_trampoline_iill_v_8(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 392, args, 4); }
+// static void svm_trampoline_iill_v_9(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 393, args, 4); }
+// static void svm_trampoline_iill_v_10(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 394, args, 4); }
+// static void svm_trampoline_iill_v_11(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 395, args, 4); }
+// static void svm_trampoline_iill_v_12(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 396, args, 4); }
+// static void svm_trampoline_iill_v_13(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 397, args, 4); }
+// static void svm_trampoline_iill_v_14(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 398, args, 4); }
+// static void svm_trampoline_iill_v_15(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 399, args, 4); }
+// static int32_t svm_trampoline_iill_i_0(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 400, args, 4); }
+// static int32_t svm_trampoline_iill_i_1(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 401, args, 4); }
+// static int32_t svm_trampoline_iill_i_2(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 402, args, 4); }
+// static int32_t svm_trampoline_iill_i_3(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 403, args, 4); }
+// static int32_t svm_trampoline_iill_i_4(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 404, args, 4); }
+// static int32_t svm_trampoline_iill_i_5(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 405, args, 4); }
+// static int32_t svm_trampoline_iill_i_6(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 406, args, 4); }
+// static int32_t svm_trampoline_iill_i_7(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 407, args, 4); }
+// static int32_t svm_trampoline_iill_i_8(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 408, args, 4); }
+// static int32_t svm_trampoline_iill_i_9(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 409, args, 4); }
+// static int32_t svm_trampoline_iill_i_10(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 410, args, 4); }
+// static int32_t svm_trampoline_iill_i_11(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 411, args, 4); }
+// static int32_t svm_trampoline_iill_i_12(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 412, args, 4); }
+// static int32_t svm_trampoline_iill_i_13(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 413, args, 4); }
+// static int32_t svm_trampoline_iill_i_14(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 414, args, 4); }
+// static int32_t svm_trampoline_iill_i_15(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 415, args, 4); }
+// static int64_t svm_trampoline_iill_l_0(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 416, args, 4); }
+// static int64_t svm_trampoline_iill_l_1(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 417, args, 4); }
+// static int64_t svm_trampoline_iill_l_2(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 418, args, 4); }
+// static int64_t svm_trampoline_iill_l_3(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 419, args, 4); }
+// static int64_t svm_trampoline_iill_l_4(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 420, args, 4); }
+// static int64_t svm_trampoline_iill_l_5(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 421, args, 4); }
+// static int64_t svm_trampoline_iill_l_6(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 422, args, 4); }
+// static int64_t svm_trampoline_iill_l_7(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 423, args, 4); }
+// static int64_t svm_trampoline_iill_l_8(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 424, args, 4); }
+// static int64_t svm_trampoline_iill_l_9(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 425, args, 4); }
+// static int64_t svm_trampoline_iill_l_10(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 426, args, 4); }
+// static int64_t svm_trampoline_iill_l_11(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 427, args, 4); }
+// static int64_t svm_trampoline_iill_l_12(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 428, args, 4); }
+// static int64_t svm_trampoline_iill_l_13(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 429, args, 4); }
+// static int64_t svm_trampoline_iill_l_14(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 430, args, 4); }
+// static int64_t svm_trampoline_iill_l_15(void *ctx, int32_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 431, args, 4); }
+// static void svm_trampoline_il_v_0(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 432, args, 2); }
+// static void svm_trampoline_il_v_1(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 433, args, 2); }
+// static void svm_trampoline_il_v_2(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 434, args, 2); }
+// static void svm_trampoline_il_v_3(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 435, args, 2); }
+// static void svm_trampoline_il_v_4(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 436, args, 2); }
+// static void svm_trampoline_il_v_5(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 437, args, 2); }
+// static void svm_trampoline_il_v_6(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 438, args, 2); }
+// static void svm_trampoline_il_v_7(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 439, args, 2); }
+// static void svm_trampoline_il_v_8(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 440, args, 2); }
+// static void svm_trampoline_il_v_9(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 441, args, 2); }
+// static void svm_trampoline_il_v_10(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 442, args, 2); }
+// static void svm_trampoline_il_v_11(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 443, args, 2); }
+// static void svm_trampoline_il_v_12(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 444, args, 2); }
+// static void svm_trampoline_il_v_13(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 445, args, 2); }
+// static void svm_trampoline_il_v_14(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 446, args, 2); }
+// static void svm_trampoline_il_v_15(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 447, args, 2); }
+// static int32_t svm_trampoline_il_i_0(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 448, args, 2); }
+// static int32_t svm_trampoline_il_i_1(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 449, args, 2); }
+// static int32_t svm_trampoline_il_i_2(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 450, args, 2); }
+// static int32_t svm_trampoline_il_i_3(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 451, args, 2); }
+// static int32_t svm_trampoline_il_i_4(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 452, args, 2); }
+// static int32_t svm_trampoline_il_i_5(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 453, args, 2); }
+// static int32_t svm_trampoline_il_i_6(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 454, args, 2); }
+// static int32_t svm_trampoline_il_i_7(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 455, args, 2); }
+// static int32_t svm_trampoline_il_i_8(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 456, args, 2); }
+// static int32_t svm_trampoline_il_i_9(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 457, args, 2); }
+// static int32_t svm_trampoline_il_i_10(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 458, args, 2); }
+// static int32_t svm_trampoline_il_i_11(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 459, args, 2); }
+// static int32_t svm_trampoline_il_i_12(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 460, args, 2); }
+// static int32_t svm_trampoline_il_i_13(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 461, args, 2); }
+// static int32_t svm_trampoline_il_i_14(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 462, args, 2); }
+// static int32_t svm_trampoline_il_i_15(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 463, args, 2); }
+// static int64_t svm_trampoline_il_l_0(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 464, args, 2); }
+// static int64_t svm_trampoline_il_l_1(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 465, args, 2); }
+// static int64_t svm_trampoline_il_l_2(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 466, args, 2); }
+// static int64_t svm_trampoline_il_l_3(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 467, args, 2); }
+// static int64_t svm_trampoline_il_l_4(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 468, args, 2); }
+// static int64_t svm_trampoline_il_l_5(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 469, args, 2); }
+// static int64_t svm_trampoline_il_l_6(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 470, args, 2); }
+// static int64_t svm_trampoline_il_l_7(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 471, args, 2); }
+// static int64_t svm_trampoline_il_l_8(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 472, args, 2); }
+// static int64_t svm_trampoline_il_l_9(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 473, args, 2); }
+// static int64_t svm_trampoline_il_l_10(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 474, args, 2); }
+// static int64_t svm_trampoline_il_l_11(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 475, args, 2); }
+// static int64_t svm_trampoline_il_l_12(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 476, args, 2); }
+// static int64_t svm_trampoline_il_l_13(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 477, args, 2); }
+// static int64_t svm_trampoline_il_l_14(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 478, args, 2); }
+// static int64_t svm_trampoline_il_l_15(void *ctx, int32_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 479, args, 2); }
+// static void svm_trampoline_ili_v_0(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 480, args, 3); }
+// static void svm_trampoline_ili_v_1(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 481, args, 3); }
+// static void svm_trampoline_ili_v_2(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 482, args, 3); }
+// static void svm_trampoline_ili_v_3(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 483, args, 3); }
+// static void svm_trampoline_ili_v_4(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 484, args, 3); }
+// static void svm_trampoline_ili_v_5(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 485, args, 3); }
+// static void svm_trampoline_ili_v_6(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 486, args, 3); }
+// static void svm_trampoline_ili_v_7(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 487, args, 3); }
+// static void svm_trampoline_ili_v_8(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 488, args, 3); }
+// static void svm_trampoline_ili_v_9(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 489, args, 3); }
+// static void svm_trampoline_ili_v_10(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 490, args, 3); }
+// static void svm_trampoline_ili_v_11(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 491, args, 3); }
+// static void svm_trampoline_ili_v_12(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 492, args, 3); }
+// static void svm_trampoline_ili_v_13(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 493, args, 3); }
+// static void svm_trampoline_ili_v_14(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 494, args, 3); }
+// static void svm_trampoline_ili_v_15(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 495, args, 3); }
+// static int32_t svm_trampoline_ili_i_0(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 496, args, 3); }
+// static int32_t svm_trampoline_ili_i_1(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 497, args, 3); }
+// static int32_t svm_trampoline_ili_i_2(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 498, args, 3); }
+// static int32_t svm_trampoline_ili_i_3(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 499, args, 3); }
+// static int32_t svm_trampoline_ili_i_4(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 500, args, 3); }
+// static int32_t svm_trampoline_ili_i_5(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 501, args, 3); }
+// static int32_t svm_trampoline_ili_i_6(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 502, args, 3); }
+// static int32_t svm_trampoline_ili_i_7(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 503, args, 3); }
+// static int32_t svm_trampoline_ili_i_8(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 504, args, 3); }
+// static int32_t svm_trampoline_ili_i_9(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 505, args, 3); }
+// static int32_t svm_trampoline_ili_i_10(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 506, args, 3); }
+// static int32_t svm_trampoline_ili_i_11(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 507, args, 3); }
+// static int32_t svm_trampoline_ili_i_12(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 508, args, 3); }
+// static int32_t svm_trampoline_ili_i_13(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 509, args, 3); }
+// static int32_t svm_trampoline_ili_i_14(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 510, args, 3); }
+// static int32_t svm_trampoline_ili_i_15(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 511, args, 3); }
+// static int64_t svm_trampoline_ili_l_0(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 512, args, 3); }
+// static int64_t svm_trampoline_ili_l_1(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 513, args, 3); }
+// static int64_t svm_trampoline_ili_l_2(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 514, args, 3); }
+// static int64_t svm_trampoline_ili_l_3(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 515, args, 3); }
+// static int64_t svm_trampoline_ili_l_4(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 516, args, 3); }
+// static int64_t svm_trampoline_ili_l_5(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 517, args, 3); }
+// static int64_t svm_trampoline_ili_l_6(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 518, args, 3); }
+// static int64_t svm_trampoline_ili_l_7(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 519, args, 3); }
+// static int64_t svm_trampoline_ili_l_8(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 520, args, 3); }
+// static int64_t svm_trampoline_ili_l_9(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 521, args, 3); }
+// static int64_t svm_trampoline_ili_l_10(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 522, args, 3); }
+// static int64_t svm_trampoline_ili_l_11(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 523, args, 3); }
+// static int64_t svm_trampoline_ili_l_12(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 524, args, 3); }
+// static int64_t svm_trampoline_ili_l_13(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 525, args, 3); }
+// static int64_t svm_trampoline_ili_l_14(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 526, args, 3); }
+// static int64_t svm_trampoline_ili_l_15(void *ctx, int32_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 527, args, 3); }
+// static void svm_trampoline_ilii_v_0(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 528, args, 4); }
+// static void svm_trampoline_ilii_v_1(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 529, args, 4); }
+// static void svm_trampoline_ilii_v_2(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 530, args, 4); }
+// static void svm_trampoline_ilii_v_3(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 531, args, 4); }
+// static void svm_trampoline_ilii_v_4(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 532, args, 4); }
+// static void svm_trampoline_ilii_v_5(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 533, args, 4); }
+// static void svm_trampoline_ilii_v_6(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 534, args, 4); }
+// static void svm_trampoline_ilii_v_7(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 535, args, 4); }
+// static void svm_trampoline_ilii_v_8(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 536, args, 4); }
+// static void svm_trampoline_ilii_v_9(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 537, args, 4); }
+// static void svm_trampoline_ilii_v_10(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 538, args, 4); }
+// static void svm_trampoline_ilii_v_11(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 539, args, 4); }
+// static void svm_trampoline_ilii_v_12(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 540, args, 4); }
+// static void svm_trampoline_ilii_v_13(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 541, args, 4); }
+// static void svm_trampoline_ilii_v_14(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 542, args, 4); }
+// static void svm_trampoline_ilii_v_15(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 543, args, 4); }
+// static int32_t svm_trampoline_ilii_i_0(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 544, args, 4); }
+// static int32_t svm_trampoline_ilii_i_1(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 545, args, 4); }
+// static int32_t svm_trampoline_ilii_i_2(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 546, args, 4); }
+// static int32_t svm_trampoline_ilii_i_3(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 547, args, 4); }
+// static int32_t svm_trampoline_ilii_i_4(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 548, args, 4); }
+// static int32_t svm_trampoline_ilii_i_5(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 549, args, 4); }
+// static int32_t svm_trampoline_ilii_i_6(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 550, args, 4); }
+// static int32_t svm_trampoline_ilii_i_7(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 551, args, 4); }
+// static int32_t svm_trampoline_ilii_i_8(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 552, args, 4); }
+// static int32_t svm_trampoline_ilii_i_9(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 553, args, 4); }
+// static int32_t svm_trampoline_ilii_i_10(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 554, args, 4); }
+// static int32_t svm_trampoline_ilii_i_11(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 555, args, 4); }
+// static int32_t svm_trampoline_ilii_i_12(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 556, args, 4); }
+// static int32_t svm_trampoline_ilii_i_13(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 557, args, 4); }
+// static int32_t svm_trampoline_ilii_i_14(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 558, args, 4); }
+// static int32_t svm_trampoline_ilii_i_15(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 559, args, 4); }
+// static int64_t svm_trampoline_ilii_l_0(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 560, args, 4); }
+// static int64_t svm_trampoline_ilii_l_1(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 561, args, 4); }
+// static int64_t svm_trampoline_ilii_l_2(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 562, args, 4); }
+// static int64_t svm_trampoline_ilii_l_3(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 563, args, 4); }
+// static int64_t svm_trampoline_ilii_l_4(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 564, args, 4); }
+// static int64_t svm_trampoline_ilii_l_5(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 565, args, 4); }
+// static int64_t svm_trampoline_ilii_l_6(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 566, args, 4); }
+// static int64_t svm_trampoline_ilii_l_7(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 567, args, 4); }
+// static int64_t svm_trampoline_ilii_l_8(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 568, args, 4); }
+// static int64_t svm_trampoline_ilii_l_9(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 569, args, 4); }
+// static int64_t svm_trampoline_ilii_l_10(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 570, args, 4); }
+// static int64_t svm_trampoline_ilii_l_11(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 571, args, 4); }
+// static int64_t svm_trampoline_ilii_l_12(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 572, args, 4); }
+// static int64_t svm_trampoline_ilii_l_13(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 573, args, 4); }
+// static int64_t svm_trampoline_ilii_l_14(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 574, args, 4); }
+// static int64_t svm_trampoline_ilii_l_15(void *ctx, int32_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 575, args, 4); }
+// static void svm_trampoline_ilil_v_0(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 576, args, 4); }
+// static void svm_trampoline_ilil_v_1(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 577, args, 4); }
+// static void svm_trampoline_ilil_v_2(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 578, args, 4); }
+// static void svm_trampoline_ilil_v_3(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 579, args, 4); }
+// static void svm_trampoline_ilil_v_4(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 580, args, 4); }
+// static void svm_trampoline_ilil_v_5(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 581, args, 4); }
+// static void svm_trampoline_ilil_v_6(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 582, args, 4); }
+// static void svm_trampoline_ilil_v_7(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 583, args, 4); }
+// static void svm_trampoline_ilil_v_8(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 584, args, 4); }
+// static void svm_trampoline_ilil_v_9(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 585, args, 4); }
+// static void svm_trampoline_ilil_v_10(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 586, args, 4); }
+// static void svm_trampoline_ilil_v_11(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 587, args, 4); }
+// static void svm_trampoline_ilil_v_12(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 588, args, 4); }
+// static void svm_trampoline_ilil_v_13(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 589, args, 4); }
+// static void svm_trampoline_ilil_v_14(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 590, args, 4); }
+// static void svm_trampoline_ilil_v_15(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 591, args, 4); }
+// static int32_t svm_trampoline_ilil_i_0(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 592, args, 4); }
+// static int32_t svm_trampoline_ilil_i_1(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 593, args, 4); }
+// static int32_t svm_trampoline_ilil_i_2(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 594, args, 4); }
+// static int32_t svm_trampoline_ilil_i_3(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 595, args, 4); }
+// static int32_t svm_trampoline_ilil_i_4(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 596, args, 4); }
+// static int32_t svm_trampoline_ilil_i_5(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 597, args, 4); }
+// static int32_t svm_trampoline_ilil_i_6(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 598, args, 4); }
+// static int32_t svm_trampoline_ilil_i_7(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 599, args, 4); }
+// static int32_t svm_trampoline_ilil_i_8(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 600, args, 4); }
+// static int32_t svm_trampoline_ilil_i_9(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 601, args, 4); }
+// static int32_t svm_trampoline_ilil_i_10(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 602, args, 4); }
+// static int32_t svm_trampoline_ilil_i_11(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 603, args, 4); }
+// static int32_t svm_trampoline_ilil_i_12(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 604, args, 4); }
+// static int32_t svm_trampoline_ilil_i_13(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 605, args, 4); }
+// static int32_t svm_trampoline_ilil_i_14(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 606, args, 4); }
+// static int32_t svm_trampoline_ilil_i_15(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 607, args, 4); }
+// static int64_t svm_trampoline_ilil_l_0(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 608, args, 4); }
+// static int64_t svm_trampoline_ilil_l_1(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 609, args, 4); }
+// static int64_t svm_trampoline_ilil_l_2(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 610, args, 4); }
+// static int64_t svm_trampoline_ilil_l_3(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 611, args, 4); }
+// static int64_t svm_trampoline_ilil_l_4(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 612, args, 4); }
+// static int64_t svm_trampoline_ilil_l_5(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 613, args, 4); }
+// static int64_t svm_trampoline_ilil_l_6(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 614, args, 4); }
+// static int64_t svm_trampoline_ilil_l_7(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 615, args, 4); }
+// static int64_t svm_trampoline_ilil_l_8(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 616, args, 4); }
+// static int64_t svm_trampoline_ilil_l_9(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 617, args, 4); }
+// static int64_t svm_trampoline_ilil_l_10(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 618, args, 4); }
+// static int64_t svm_trampoline_ilil_l_11(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 619, args, 4); }
+// static int64_t svm_trampoline_ilil_l_12(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 620, args, 4); }
+// static int64_t svm_trampoline_ilil_l_13(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 621, args, 4); }
+// static int64_t svm_trampoline_ilil_l_14(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 622, args, 4); }
+// static int64_t svm_trampoline_ilil_l_15(void *ctx, int32_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 623, args, 4); }
+// static void svm_trampoline_ill_v_0(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 624, args, 3); }
+// static void svm_trampoline_ill_v_1(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 625, args, 3); }
+// static void svm_trampoline_ill_v_2(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 626, args, 3); }
+// static void svm_trampoline_ill_v_3(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 627, args, 3); }
+// static void svm_trampoline_ill_v_4(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 628, args, 3); }
+// static void svm_trampoline_ill_v_5(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 629, args, 3); }
+// static void svm_trampoline_ill_v_6(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 630, args, 3); }
+// static void svm_trampoline_ill_v_7(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 631, args, 3); }
+// static void svm_trampoline_ill_v_8(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 632, args, 3); }
+// static void svm_trampoline_ill_v_9(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 633, args, 3); }
+// static void svm_trampoline_ill_v_10(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 634, args, 3); }
+// static void svm_trampoline_ill_v_11(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 635, args, 3); }
+// static void svm_trampoline_ill_v_12(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 636, args, 3); }
+// static void svm_trampoline_ill_v_13(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 637, args, 3); }
+// static void svm_trampoline_ill_v_14(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 638, args, 3); }
+// static void svm_trampoline_ill_v_15(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 639, args, 3); }
+// static int32_t svm_trampoline_ill_i_0(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 640, args, 3); }
+// static int32_t svm_trampoline_ill_i_1(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 641, args, 3); }
+// static int32_t svm_trampoline_ill_i_2(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 642, args, 3); }
+// static int32_t svm_trampoline_ill_i_3(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 643, args, 3); }
+// static int32_t svm_trampoline_ill_i_4(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 644, args, 3); }
+// static int32_t svm_trampoline_ill_i_5(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 645, args, 3); }
+// static int32_t svm_trampoline_ill_i_6(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 646, args, 3); }
+// static int32_t svm_trampoline_ill_i_7(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 647, args, 3); }
+// static int32_t svm_trampoline_ill_i_8(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 648, args, 3); }
+// static int32_t svm_trampoline_ill_i_9(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 649, args, 3); }
+// static int32_t svm_trampoline_ill_i_10(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 650, args, 3); }
+// static int32_t svm_trampoline_ill_i_11(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 651, args, 3); }
+// static int32_t svm_trampoline_ill_i_12(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 652, args, 3); }
+// static int32_t svm_trampoline_ill_i_13(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 653, args, 3); }
+// static int32_t svm_trampoline_ill_i_14(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 654, args, 3); }
+// static int32_t svm_trampoline_ill_i_15(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 655, args, 3); }
+// static int64_t svm_trampoline_ill_l_0(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 656, args, 3); }
+// static int64_t svm_trampoline_ill_l_1(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 657, args, 3); }
+// static int64_t svm_trampoline_ill_l_2(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 658, args, 3); }
+// static int64_t svm_trampoline_ill_l_3(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 659, args, 3); }
+// static int64_t svm_trampoline_ill_l_4(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 660, args, 3); }
+// static int64_t svm_trampoline_ill_l_5(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 661, args, 3); }
+// static int64_t svm_trampoline_ill_l_6(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 662, args, 3); }
+// static int64_t svm_trampoline_ill_l_7(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 663, args, 3); }
+// static int64_t svm_trampoline_ill_l_8(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 664, args, 3); }
+// static int64_t svm_trampoline_ill_l_9(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 665, args, 3); }
+// static int64_t svm_trampoline_ill_l_10(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 666, args, 3); }
+// static int64_t svm_trampoline_ill_l_11(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 667, args, 3); }
+// static int64_t svm_trampoline_ill_l_12(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 668, args, 3); }
+// static int64_t svm_trampoline_ill_l_13(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 669, args, 3); }
+// static int64_t svm_trampoline_ill_l_14(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 670, args, 3); }
+// static int64_t svm_trampoline_ill_l_15(void *ctx, int32_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 671, args, 3); }
+// static void svm_trampoline_illi_v_0(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 672, args, 4); }
+// static void svm_trampoline_illi_v_1(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 673, args, 4); }
+// static void svm_trampoline_illi_v_2(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 674, args, 4); }
+// static void svm_trampoline_illi_v_3(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 675, args, 4); }
+// static void svm_trampoline_illi_v_4(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 676, args, 4); }
+// static void svm_trampoline_illi_v_5(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 677, args, 4); }
+// static void svm_trampoline_illi_v_6(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 678, args, 4); }
+// static void svm_trampoline_illi_v_7(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 679, args, 4); }
+// static void svm_trampoline_illi_v_8(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 680, args, 4); }
+// static void svm_trampoline_illi_v_9(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 681, args, 4); }
+// static void svm_trampoline_illi_v_10(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 682, args, 4); }
+// static void svm_trampoline_illi_v_11(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 683, args, 4); }
+// static void svm_trampoline_illi_v_12(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 684, args, 4); }
+// static void svm_trampoline_illi_v_13(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 685, args, 4); }
+// static void svm_trampoline_illi_v_14(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 686, args, 4); }
+// static void svm_trampoline_illi_v_15(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 687, args, 4); }
+// static int32_t svm_trampoline_illi_i_0(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 688, args, 4); }
+// static int32_t svm_trampoline_illi_i_1(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 689, args, 4); }
+// static int32_t svm_trampoline_illi_i_2(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 690, args, 4); }
+// static int32_t svm_trampoline_illi_i_3(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 691, args, 4); }
+// static int32_t svm_trampoline_illi_i_4(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 692, args, 4); }
+// static int32_t svm_trampoline_illi_i_5(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 693, args, 4); }
+// static int32_t svm_trampoline_illi_i_6(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 694, args, 4); }
+// static int32_t svm_trampoline_illi_i_7(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 695, args, 4); }
+// static int32_t svm_trampoline_illi_i_8(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 696, args, 4); }
+// static int32_t svm_trampoline_illi_i_9(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 697, args, 4); }
+// static int32_t svm_trampoline_illi_i_10(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 698, args, 4); }
+// static int32_t svm_trampoline_illi_i_11(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 699, args, 4); }
+// static int32_t svm_trampoline_illi_i_12(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 700, args, 4); }
+// static int32_t svm_trampoline_illi_i_13(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 701, args, 4); }
+// static int32_t svm_trampoline_illi_i_14(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 702, args, 4); }
+// static int32_t svm_trampoline_illi_i_15(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 703, args, 4); }
+// static int64_t svm_trampoline_illi_l_0(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 704, args, 4); }
+// static int64_t svm_trampoline_illi_l_1(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 705, args, 4); }
+// static int64_t svm_trampoline_illi_l_2(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 706, args, 4); }
+// static int64_t svm_trampoline_illi_l_3(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 707, args, 4); }
+// static int64_t svm_trampoline_illi_l_4(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 708, args, 4); }
+// static int64_t svm_trampoline_illi_l_5(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 709, args, 4); }
+// static int64_t svm_trampoline_illi_l_6(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 710, args, 4); }
+// static int64_t svm_trampoline_illi_l_7(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 711, args, 4); }
+// static int64_t svm_trampoline_illi_l_8(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 712, args, 4); }
+// static int64_t svm_trampoline_illi_l_9(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 713, args, 4); }
+// static int64_t svm_trampoline_illi_l_10(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 714, args, 4); }
+// static int64_t svm_trampoline_illi_l_11(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 715, args, 4); }
+// static int64_t svm_trampoline_illi_l_12(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 716, args, 4); }
+// static int64_t svm_trampoline_illi_l_13(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 717, args, 4); }
+// static int64_t svm_trampoline_illi_l_14(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 718, args, 4); }
+// static int64_t svm_trampoline_illi_l_15(void *ctx, int32_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 719, args, 4); }
+// static void svm_trampoline_illl_v_0(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 720, args, 4); }
+// static void svm_trampoline_illl_v_1(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 721, args, 4); }
+// static void svm_trampoline_illl_v_2(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 722, args, 4); }
+// static void svm_trampoline_illl_v_3(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 723, args, 4); }
+// static void svm_trampoline_illl_v_4(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 724, args, 4); }
+// static void svm_trampoline_illl_v_5(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 725, args, 4); }
+// static void svm_trampoline_illl_v_6(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 726, args, 4); }
+// static void svm_trampoline_illl_v_7(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 727, args, 4); }
+// static void svm_trampoline_illl_v_8(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 728, args, 4); }
+// static void svm_trampoline_illl_v_9(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 729, args, 4); }
+// static void svm_trampoline_illl_v_10(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 730, args, 4); }
+// static void svm_trampoline_illl_v_11(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 731, args, 4); }
+// static void svm_trampoline_illl_v_12(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 732, args, 4); }
+// static void svm_trampoline_illl_v_13(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 733, args, 4); }
+// static void svm_trampoline_illl_v_14(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 734, args, 4); }
+// static void svm_trampoline_illl_v_15(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 735, args, 4); }
+// static int32_t svm_trampoline_illl_i_0(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 736, args, 4); }
+// static int32_t svm_trampoline_illl_i_1(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 737, args, 4); }
+// static int32_t svm_trampoline_illl_i_2(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 738, args, 4); }
+// static int32_t svm_trampoline_illl_i_3(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 739, args, 4); }
+// static int32_t svm_trampoline_illl_i_4(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 740, args, 4); }
+// static int32_t svm_trampoline_illl_i_5(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 741, args, 4); }
+// static int32_t svm_trampoline_illl_i_6(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 742, args, 4); }
+// static int32_t svm_trampoline_illl_i_7(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 743, args, 4); }
+// static int32_t svm_trampoline_illl_i_8(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 744, args, 4); }
+// static int32_t svm_trampoline_illl_i_9(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 745, args, 4); }
+// static int32_t svm_trampoline_illl_i_10(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 746, args, 4); }
+// static int32_t svm_trampoline_illl_i_11(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 747, args, 4); }
+// static int32_t svm_trampoline_illl_i_12(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 748, args, 4); }
+// static int32_t svm_trampoline_illl_i_13(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 749, args, 4); }
+// static int32_t svm_trampoline_illl_i_14(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 750, args, 4); }
+// static int32_t svm_trampoline_illl_i_15(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 751, args, 4); }
+// static int64_t svm_trampoline_illl_l_0(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 752, args, 4); }
+// static int64_t svm_trampoline_illl_l_1(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 753, args, 4); }
+// static int64_t svm_trampoline_illl_l_2(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 754, args, 4); }
+// static int64_t svm_trampoline_illl_l_3(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 755, args, 4); }
+// static int64_t svm_trampoline_illl_l_4(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 756, args, 4); }
+// static int64_t svm_trampoline_illl_l_5(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 757, args, 4); }
+// static int64_t svm_trampoline_illl_l_6(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 758, args, 4); }
+// static int64_t svm_trampoline_illl_l_7(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 759, args, 4); }
+// static int64_t svm_trampoline_illl_l_8(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 760, args, 4); }
+// static int64_t svm_trampoline_illl_l_9(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 761, args, 4); }
+// static int64_t svm_trampoline_illl_l_10(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 762, args, 4); }
+// static int64_t svm_trampoline_illl_l_11(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 763, args, 4); }
+// static int64_t svm_trampoline_illl_l_12(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 764, args, 4); }
+// static int64_t svm_trampoline_illl_l_13(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 765, args, 4); }
+// static int64_t svm_trampoline_illl_l_14(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 766, args, 4); }
+// static int64_t svm_trampoline_illl_l_15(void *ctx, int32_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 767, args, 4); }
+// static void svm_trampoline_l_v_0(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 768, args, 1); }
+// static void svm_trampoline_l_v_1(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 769, args, 1); }
+// static void svm_trampoline_l_v_2(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 770, args, 1); }
+// static void svm_trampoline_l_v_3(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 771, args, 1); }
+// static void svm_trampoline_l_v_4(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 772, args, 1); }
+// static void svm_trampoline_l_v_5(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 773, args, 1); }
+// static void svm_trampoline_l_v_6(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 774, args, 1); }
+// static void svm_trampoline_l_v_7(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 775, args, 1); }
+// static void svm_trampoline_l_v_8(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 776, args, 1); }
+// static void svm_trampoline_l_v_9(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 777, args, 1); }
+// static void svm_trampoline_l_v_10(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 778, args, 1); }
+// static void svm_trampoline_l_v_11(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 779, args, 1); }
+// static void svm_trampoline_l_v_12(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 780, args, 1); }
+// static void svm_trampoline_l_v_13(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 781, args, 1); }
+// static void svm_trampoline_l_v_14(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 782, args, 1); }
+// static void svm_trampoline_l_v_15(void *ctx, int64_t a0) { int64_t args[1] = {a0}; svm_import_dispatch(ctx, 783, args, 1); }
+// static int32_t svm_trampoline_l_i_0(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 784, args, 1); }
+// static int32_t svm_trampoline_l_i_1(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 785, args, 1); }
+// static int32_t svm_trampoline_l_i_2(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 786, args, 1); }
+// static int32_t svm_trampoline_l_i_3(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 787, args, 1); }
+// static int32_t svm_trampoline_l_i_4(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 788, args, 1); }
+// static int32_t svm_trampoline_l_i_5(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 789, args, 1); }
+// static int32_t svm_trampoline_l_i_6(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 790, args, 1); }
+// static int32_t svm_trampoline_l_i_7(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 791, args, 1); }
+// static int32_t svm_trampoline_l_i_8(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 792, args, 1); }
+// static int32_t svm_trampoline_l_i_9(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 793, args, 1); }
+// static int32_t svm_trampoline_l_i_10(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 794, args, 1); }
+// static int32_t svm_trampoline_l_i_11(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 795, args, 1); }
+// static int32_t svm_trampoline_l_i_12(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 796, args, 1); }
+// static int32_t svm_trampoline_l_i_13(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 797, args, 1); }
+// static int32_t svm_trampoline_l_i_14(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 798, args, 1); }
+// static int32_t svm_trampoline_l_i_15(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int32_t)svm_import_dispatch(ctx, 799, args, 1); }
+// static int64_t svm_trampoline_l_l_0(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 800, args, 1); }
+// static int64_t svm_trampoline_l_l_1(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 801, args, 1); }
+// static int64_t svm_trampoline_l_l_2(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 802, args, 1); }
+// static int64_t svm_trampoline_l_l_3(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 803, args, 1); }
+// static int64_t svm_trampoline_l_l_4(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 804, args, 1); }
+// static int64_t svm_trampoline_l_l_5(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 805, args, 1); }
+// static int64_t svm_trampoline_l_l_6(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 806, args, 1); }
+// static int64_t svm_trampoline_l_l_7(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 807, args, 1); }
+// static int64_t svm_trampoline_l_l_8(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 808, args, 1); }
+// static int64_t svm_trampoline_l_l_9(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 809, args, 1); }
+// static int64_t svm_trampoline_l_l_10(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 810, args, 1); }
+// static int64_t svm_trampoline_l_l_11(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 811, args, 1); }
+// static int64_t svm_trampoline_l_l_12(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 812, args, 1); }
+// static int64_t svm_trampoline_l_l_13(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 813, args, 1); }
+// static int64_t svm_trampoline_l_l_14(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 814, args, 1); }
+// static int64_t svm_trampoline_l_l_15(void *ctx, int64_t a0) { int64_t args[1] = {a0}; return (int64_t)svm_import_dispatch(ctx, 815, args, 1); }
+// static void svm_trampoline_li_v_0(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 816, args, 2); }
+// static void svm_trampoline_li_v_1(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 817, args, 2); }
+// static void svm_trampoline_li_v_2(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 818, args, 2); }
+// static void svm_trampoline_li_v_3(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 819, args, 2); }
+// static void svm_trampoline_li_v_4(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 820, args, 2); }
+// static void svm_trampoline_li_v_5(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 821, args, 2); }
+// static void svm_trampoline_li_v_6(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 822, args, 2); }
+// static void svm_trampoline_li_v_7(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 823, args, 2); }
+// static void svm_trampoline_li_v_8(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 824, args, 2); }
+// static void svm_trampoline_li_v_9(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 825, args, 2); }
+// static void svm_trampoline_li_v_10(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 826, args, 2); }
+// static void svm_trampoline_li_v_11(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 827, args, 2); }
+// static void svm_trampoline_li_v_12(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 828, args, 2); }
+// static void svm_trampoline_li_v_13(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 829, args, 2); }
+// static void svm_trampoline_li_v_14(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 830, args, 2); }
+// static void svm_trampoline_li_v_15(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 831, args, 2); }
+// static int32_t svm_trampoline_li_i_0(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 832, args, 2); }
+// static int32_t svm_trampoline_li_i_1(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 833, args, 2); }
+// static int32_t svm_trampoline_li_i_2(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 834, args, 2); }
+// static int32_t svm_trampoline_li_i_3(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 835, args, 2); }
+// static int32_t svm_trampoline_li_i_4(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 836, args, 2); }
+// static int32_t svm_trampoline_li_i_5(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 837, args, 2); }
+// static int32_t svm_trampoline_li_i_6(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 838, args, 2); }
+// static int32_t svm_trampoline_li_i_7(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 839, args, 2); }
+// static int32_t svm_trampoline_li_i_8(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 840, args, 2); }
+// static int32_t svm_trampoline_li_i_9(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 841, args, 2); }
+// static int32_t svm_trampoline_li_i_10(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 842, args, 2); }
+// static int32_t svm_trampoline_li_i_11(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 843, args, 2); }
+// static int32_t svm_trampoline_li_i_12(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 844, args, 2); }
+// static int32_t svm_trampoline_li_i_13(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 845, args, 2); }
+// static int32_t svm_trampoline_li_i_14(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 846, args, 2); }
+// static int32_t svm_trampoline_li_i_15(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 847, args, 2); }
+// static int64_t svm_trampoline_li_l_0(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 848, args, 2); }
+// static int64_t svm_trampoline_li_l_1(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 849, args, 2); }
+// static int64_t svm_trampoline_li_l_2(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 850, args, 2); }
+// static int64_t svm_trampoline_li_l_3(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 851, args, 2); }
+// static int64_t svm_trampoline_li_l_4(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 852, args, 2); }
+// static int64_t svm_trampoline_li_l_5(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 853, args, 2); }
+// static int64_t svm_trampoline_li_l_6(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 854, args, 2); }
+// static int64_t svm_trampoline_li_l_7(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 855, args, 2); }
+// static int64_t svm_trampoline_li_l_8(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 856, args, 2); }
+// static int64_t svm_trampoline_li_l_9(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 857, args, 2); }
+// static int64_t svm_trampoline_li_l_10(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 858, args, 2); }
+// static int64_t svm_trampoline_li_l_11(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 859, args, 2); }
+// static int64_t svm_trampoline_li_l_12(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 860, args, 2); }
+// static int64_t svm_trampoline_li_l_13(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 861, args, 2); }
+// static int64_t svm_trampoline_li_l_14(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 862, args, 2); }
+// static int64_t svm_trampoline_li_l_15(void *ctx, int64_t a0, int32_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 863, args, 2); }
+// static void svm_trampoline_lii_v_0(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 864, args, 3); }
+// static void svm_trampoline_lii_v_1(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 865, args, 3); }
+// static void svm_trampoline_lii_v_2(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 866, args, 3); }
+// static void svm_trampoline_lii_v_3(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 867, args, 3); }
+// static void svm_trampoline_lii_v_4(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 868, args, 3); }
+// static void svm_trampoline_lii_v_5(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 869, args, 3); }
+// static void svm_trampoline_lii_v_6(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 870, args, 3); }
+// static void svm_trampoline_lii_v_7(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 871, args, 3); }
+// static void svm_trampoline_lii_v_8(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 872, args, 3); }
+// static void svm_trampoline_lii_v_9(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 873, args, 3); }
+// static void svm_trampoline_lii_v_10(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 874, args, 3); }
+// static void svm_trampoline_lii_v_11(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 875, args, 3); }
+// static void svm_trampoline_lii_v_12(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 876, args, 3); }
+// static void svm_trampoline_lii_v_13(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 877, args, 3); }
+// static void svm_trampoline_lii_v_14(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 878, args, 3); }
+// static void svm_trampoline_lii_v_15(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 879, args, 3); }
+// static int32_t svm_trampoline_lii_i_0(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 880, args, 3); }
+// static int32_t svm_trampoline_lii_i_1(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 881, args, 3); }
+// static int32_t svm_trampoline_lii_i_2(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 882, args, 3); }
+// static int32_t svm_trampoline_lii_i_3(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 883, args, 3); }
+// static int32_t svm_trampoline_lii_i_4(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 884, args, 3); }
+// static int32_t svm_trampoline_lii_i_5(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 885, args, 3); }
+// static int32_t svm_trampoline_lii_i_6(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 886, args, 3); }
+// static int32_t svm_trampoline_lii_i_7(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 887, args, 3); }
+// static int32_t svm_trampoline_lii_i_8(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 888, args, 3); }
+// static int32_t svm_trampoline_lii_i_9(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 889, args, 3); }
+// static int32_t svm_trampoline_lii_i_10(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 890, args, 3); }
+// static int32_t svm_trampoline_lii_i_11(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 891, args, 3); }
+// static int32_t svm_trampoline_lii_i_12(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 892, args, 3); }
+// static int32_t svm_trampoline_lii_i_13(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 893, args, 3); }
+// static int32_t svm_trampoline_lii_i_14(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 894, args, 3); }
+// static int32_t svm_trampoline_lii_i_15(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 895, args, 3); }
+// static int64_t svm_trampoline_lii_l_0(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 896, args, 3); }
+// static int64_t svm_trampoline_lii_l_1(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 897, args, 3); }
+// static int64_t svm_trampoline_lii_l_2(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 898, args, 3); }
+// static int64_t svm_trampoline_lii_l_3(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 899, args, 3); }
+// static int64_t svm_trampoline_lii_l_4(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 900, args, 3); }
+// static int64_t svm_trampoline_lii_l_5(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 901, args, 3); }
+// static int64_t svm_trampoline_lii_l_6(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 902, args, 3); }
+// static int64_t svm_trampoline_lii_l_7(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 903, args, 3); }
+// static int64_t svm_trampoline_lii_l_8(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 904, args, 3); }
+// static int64_t svm_trampoline_lii_l_9(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 905, args, 3); }
+// static int64_t svm_trampoline_lii_l_10(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 906, args, 3); }
+// static int64_t svm_trampoline_lii_l_11(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 907, args, 3); }
+// static int64_t svm_trampoline_lii_l_12(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 908, args, 3); }
+// static int64_t svm_trampoline_lii_l_13(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 909, args, 3); }
+// static int64_t svm_trampoline_lii_l_14(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 910, args, 3); }
+// static int64_t svm_trampoline_lii_l_15(void *ctx, int64_t a0, int32_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 911, args, 3); }
+// static void svm_trampoline_liii_v_0(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 912, args, 4); }
+// static void svm_trampoline_liii_v_1(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 913, args, 4); }
+// static void svm_trampoline_liii_v_2(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 914, args, 4); }
+// static void svm_trampoline_liii_v_3(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 915, args, 4); }
+// static void svm_trampoline_liii_v_4(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 916, args, 4); }
+// static void svm_trampoline_liii_v_5(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 917, args, 4); }
+// static void svm_trampoline_liii_v_6(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 918, args, 4); }
+// static void svm_trampoline_liii_v_7(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 919, args, 4); }
+// static void svm_trampoline_liii_v_8(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 920, args, 4); }
+// static void svm_trampoline_liii_v_9(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 921, args, 4); }
+// static void svm_trampoline_liii_v_10(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 922, args, 4); }
+// static void svm_trampoline_liii_v_11(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 923, args, 4); }
+// static void svm_trampoline_liii_v_12(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 924, args, 4); }
+// static void svm_trampoline_liii_v_13(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 925, args, 4); }
+// static void svm_trampoline_liii_v_14(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 926, args, 4); }
+// static void svm_trampoline_liii_v_15(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 927, args, 4); }
+// static int32_t svm_trampoline_liii_i_0(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 928, args, 4); }
+// static int32_t svm_trampoline_liii_i_1(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 929, args, 4); }
+// static int32_t svm_trampoline_liii_i_2(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 930, args, 4); }
+// static int32_t svm_trampoline_liii_i_3(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 931, args, 4); }
+// static int32_t svm_trampoline_liii_i_4(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 932, args, 4); }
+// static int32_t svm_trampoline_liii_i_5(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 933, args, 4); }
+// static int32_t svm_trampoline_liii_i_6(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 934, args, 4); }
+// static int32_t svm_trampoline_liii_i_7(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 935, args, 4); }
+// static int32_t svm_trampoline_liii_i_8(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 936, args, 4); }
+// static int32_t svm_trampoline_liii_i_9(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 937, args, 4); }
+// static int32_t svm_trampoline_liii_i_10(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 938, args, 4); }
+// static int32_t svm_trampoline_liii_i_11(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 939, args, 4); }
+// static int32_t svm_trampoline_liii_i_12(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 940, args, 4); }
+// static int32_t svm_trampoline_liii_i_13(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 941, args, 4); }
+// static int32_t svm_trampoline_liii_i_14(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 942, args, 4); }
+// static int32_t svm_trampoline_liii_i_15(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 943, args, 4); }
+// static int64_t svm_trampoline_liii_l_0(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 944, args, 4); }
+// static int64_t svm_trampoline_liii_l_1(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 945, args, 4); }
+// static int64_t svm_trampoline_liii_l_2(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 946, args, 4); }
+// static int64_t svm_trampoline_liii_l_3(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 947, args, 4); }
+// static int64_t svm_trampoline_liii_l_4(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 948, args, 4); }
+// static int64_t svm_trampoline_liii_l_5(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 949, args, 4); }
+// static int64_t svm_trampoline_liii_l_6(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 950, args, 4); }
+// static int64_t svm_trampoline_liii_l_7(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 951, args, 4); }
+// static int64_t svm_trampoline_liii_l_8(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 952, args, 4); }
+// static int64_t svm_trampoline_liii_l_9(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 953, args, 4); }
+// static int64_t svm_trampoline_liii_l_10(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 954, args, 4); }
+// static int64_t svm_trampoline_liii_l_11(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 955, args, 4); }
+// static int64_t svm_trampoline_liii_l_12(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 956, args, 4); }
+// static int64_t svm_trampoline_liii_l_13(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 957, args, 4); }
+// static int64_t svm_trampoline_liii_l_14(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 958, args, 4); }
+// static int64_t svm_trampoline_liii_l_15(void *ctx, int64_t a0, int32_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 959, args, 4); }
+// static void svm_trampoline_liil_v_0(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 960, args, 4); }
+// static void svm_trampoline_liil_v_1(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 961, args, 4); }
+// static void svm_trampoline_liil_v_2(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 962, args, 4); }
+// static void svm_trampoline_liil_v_3(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 963, args, 4); }
+// static void svm_trampoline_liil_v_4(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 964, args, 4); }
+// static void svm_trampoline_liil_v_5(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 965, args, 4); }
+// static void svm_trampoline_liil_v_6(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 966, args, 4); }
+// static void svm_trampoline_liil_v_7(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 967, args, 4); }
+// static void svm_trampoline_liil_v_8(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 968, args, 4); }
+// static void svm_trampoline_liil_v_9(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 969, args, 4); }
+// static void svm_trampoline_liil_v_10(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 970, args, 4); }
+// static void svm_trampoline_liil_v_11(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 971, args, 4); }
+// static void svm_trampoline_liil_v_12(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 972, args, 4); }
+// static void svm_trampoline_liil_v_13(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 973, args, 4); }
+// static void svm_trampoline_liil_v_14(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 974, args, 4); }
+// static void svm_trampoline_liil_v_15(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 975, args, 4); }
+// static int32_t svm_trampoline_liil_i_0(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 976, args, 4); }
+// static int32_t svm_trampoline_liil_i_1(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 977, args, 4); }
+// static int32_t svm_trampoline_liil_i_2(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 978, args, 4); }
+// static int32_t svm_trampoline_liil_i_3(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 979, args, 4); }
+// static int32_t svm_trampoline_liil_i_4(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 980, args, 4); }
+// static int32_t svm_trampoline_liil_i_5(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 981, args, 4); }
+// static int32_t svm_trampoline_liil_i_6(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 982, args, 4); }
+// static int32_t svm_trampoline_liil_i_7(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 983, args, 4); }
+// static int32_t svm_trampoline_liil_i_8(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 984, args, 4); }
+// static int32_t svm_trampoline_liil_i_9(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 985, args, 4); }
+// static int32_t svm_trampoline_liil_i_10(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 986, args, 4); }
+// static int32_t svm_trampoline_liil_i_11(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 987, args, 4); }
+// static int32_t svm_trampoline_liil_i_12(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 988, args, 4); }
+// static int32_t svm_trampoline_liil_i_13(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 989, args, 4); }
+// static int32_t svm_trampoline_liil_i_14(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 990, args, 4); }
+// static int32_t svm_trampoline_liil_i_15(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 991, args, 4); }
+// static int64_t svm_trampoline_liil_l_0(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 992, args, 4); }
+// static int64_t svm_trampoline_liil_l_1(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 993, args, 4); }
+// static int64_t svm_trampoline_liil_l_2(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 994, args, 4); }
+// static int64_t svm_trampoline_liil_l_3(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 995, args, 4); }
+// static int64_t svm_trampoline_liil_l_4(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 996, args, 4); }
+// static int64_t svm_trampoline_liil_l_5(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 997, args, 4); }
+// static int64_t svm_trampoline_liil_l_6(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 998, args, 4); }
+// static int64_t svm_trampoline_liil_l_7(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 999, args, 4); }
+// static int64_t svm_trampoline_liil_l_8(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1000, args, 4); }
+// static int64_t svm_trampoline_liil_l_9(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1001, args, 4); }
+// static int64_t svm_trampoline_liil_l_10(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1002, args, 4); }
+// static int64_t svm_trampoline_liil_l_11(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1003, args, 4); }
+// static int64_t svm_trampoline_liil_l_12(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1004, args, 4); }
+// static int64_t svm_trampoline_liil_l_13(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1005, args, 4); }
+// static int64_t svm_trampoline_liil_l_14(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1006, args, 4); }
+// static int64_t svm_trampoline_liil_l_15(void *ctx, int64_t a0, int32_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1007, args, 4); }
+// static void svm_trampoline_lil_v_0(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1008, args, 3); }
+// static void svm_trampoline_lil_v_1(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1009, args, 3); }
+// static void svm_trampoline_lil_v_2(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1010, args, 3); }
+// static void svm_trampoline_lil_v_3(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1011, args, 3); }
+// static void svm_trampoline_lil_v_4(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1012, args, 3); }
+// static void svm_trampoline_lil_v_5(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1013, args, 3); }
+// static void svm_trampoline_lil_v_6(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1014, args, 3); }
+// static void svm_trampoline_lil_v_7(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1015, args, 3); }
+// static void svm_trampoline_lil_v_8(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1016, args, 3); }
+// static void svm_trampoline_lil_v_9(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1017, args, 3); }
+// static void svm_trampoline_lil_v_10(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1018, args, 3); }
+// static void svm_trampoline_lil_v_11(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1019, args, 3); }
+// static void svm_trampoline_lil_v_12(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1020, args, 3); }
+// static void svm_trampoline_lil_v_13(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1021, args, 3); }
+// static void svm_trampoline_lil_v_14(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1022, args, 3); }
+// static void svm_trampoline_lil_v_15(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1023, args, 3); }
+// static int32_t svm_trampoline_lil_i_0(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1024, args, 3); }
+// static int32_t svm_trampoline_lil_i_1(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1025, args, 3); }
+// static int32_t svm_trampoline_lil_i_2(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1026, args, 3); }
+// static int32_t svm_trampoline_lil_i_3(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1027, args, 3); }
+// static int32_t svm_trampoline_lil_i_4(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1028, args, 3); }
+// static int32_t svm_trampoline_lil_i_5(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1029, args, 3); }
+// static int32_t svm_trampoline_lil_i_6(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1030, args, 3); }
+// static int32_t svm_trampoline_lil_i_7(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1031, args, 3); }
+// static int32_t svm_trampoline_lil_i_8(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1032, args, 3); }
+// static int32_t svm_trampoline_lil_i_9(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1033, args, 3); }
+// static int32_t svm_trampoline_lil_i_10(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1034, args, 3); }
+// static int32_t svm_trampoline_lil_i_11(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1035, args, 3); }
+// static int32_t svm_trampoline_lil_i_12(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1036, args, 3); }
+// static int32_t svm_trampoline_lil_i_13(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1037, args, 3); }
+// static int32_t svm_trampoline_lil_i_14(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1038, args, 3); }
+// static int32_t svm_trampoline_lil_i_15(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1039, args, 3); }
+// static int64_t svm_trampoline_lil_l_0(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1040, args, 3); }
+// static int64_t svm_trampoline_lil_l_1(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1041, args, 3); }
+// static int64_t svm_trampoline_lil_l_2(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1042, args, 3); }
+// static int64_t svm_trampoline_lil_l_3(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1043, args, 3); }
+// static int64_t svm_trampoline_lil_l_4(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1044, args, 3); }
+// static int64_t svm_trampoline_lil_l_5(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1045, args, 3); }
+// static int64_t svm_trampoline_lil_l_6(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1046, args, 3); }
+// static int64_t svm_trampoline_lil_l_7(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1047, args, 3); }
+// static int64_t svm_trampoline_lil_l_8(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1048, args, 3); }
+// static int64_t svm_trampoline_lil_l_9(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1049, args, 3); }
+// static int64_t svm_trampoline_lil_l_10(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1050, args, 3); }
+// static int64_t svm_trampoline_lil_l_11(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1051, args, 3); }
+// static int64_t svm_trampoline_lil_l_12(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1052, args, 3); }
+// static int64_t svm_trampoline_lil_l_13(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1053, args, 3); }
+// static int64_t svm_trampoline_lil_l_14(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1054, args, 3); }
+// static int64_t svm_trampoline_lil_l_15(void *ctx, int64_t a0, int32_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1055, args, 3); }
+// static void svm_trampoline_lili_v_0(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1056, args, 4); }
+// static void svm_trampoline_lili_v_1(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1057, args, 4); }
+// static void svm_trampoline_lili_v_2(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1058, args, 4); }
+// static void svm_trampoline_lili_v_3(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1059, args, 4); }
+// static void svm_trampoline_lili_v_4(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1060, args, 4); }
+// static void svm_trampoline_lili_v_5(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1061, args, 4); }
+// static void svm_trampoline_lili_v_6(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1062, args, 4); }
+// static void svm_trampoline_lili_v_7(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1063, args, 4); }
+// static void svm_trampoline_lili_v_8(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1064, args, 4); }
+// static void svm_trampoline_lili_v_9(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1065, args, 4); }
+// static void svm_trampoline_lili_v_10(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1066, args, 4); }
+// static void svm_trampoline_lili_v_11(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1067, args, 4); }
+// static void svm_trampoline_lili_v_12(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1068, args, 4); }
+// static void svm_trampoline_lili_v_13(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1069, args, 4); }
+// static void svm_trampoline_lili_v_14(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1070, args, 4); }
+// static void svm_trampoline_lili_v_15(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1071, args, 4); }
+// static int32_t svm_trampoline_lili_i_0(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1072, args, 4); }
+// static int32_t svm_trampoline_lili_i_1(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1073, args, 4); }
+// static int32_t svm_trampoline_lili_i_2(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1074, args, 4); }
+// static int32_t svm_trampoline_lili_i_3(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1075, args, 4); }
+// static int32_t svm_trampoline_lili_i_4(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1076, args, 4); }
+// static int32_t svm_trampoline_lili_i_5(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1077, args, 4); }
+// static int32_t svm_trampoline_lili_i_6(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1078, args, 4); }
+// static int32_t svm_trampoline_lili_i_7(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1079, args, 4); }
+// static int32_t svm_trampoline_lili_i_8(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1080, args, 4); }
+// static int32_t svm_trampoline_lili_i_9(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1081, args, 4); }
+// static int32_t svm_trampoline_lili_i_10(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1082, args, 4); }
+// static int32_t svm_trampoline_lili_i_11(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1083, args, 4); }
+// static int32_t svm_trampoline_lili_i_12(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1084, args, 4); }
+// static int32_t svm_trampoline_lili_i_13(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1085, args, 4); }
+// static int32_t svm_trampoline_lili_i_14(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1086, args, 4); }
+// static int32_t svm_trampoline_lili_i_15(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1087, args, 4); }
+// static int64_t svm_trampoline_lili_l_0(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1088, args, 4); }
+// static int64_t svm_trampoline_lili_l_1(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1089, args, 4); }
+// static int64_t svm_trampoline_lili_l_2(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1090, args, 4); }
+// static int64_t svm_trampoline_lili_l_3(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1091, args, 4); }
+// static int64_t svm_trampoline_lili_l_4(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1092, args, 4); }
+// static int64_t svm_trampoline_lili_l_5(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1093, args, 4); }
+// static int64_t svm_trampoline_lili_l_6(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1094, args, 4); }
+// static int64_t svm_trampoline_lili_l_7(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1095, args, 4); }
+// static int64_t svm_trampoline_lili_l_8(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1096, args, 4); }
+// static int64_t svm_trampoline_lili_l_9(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1097, args, 4); }
+// static int64_t svm_trampoline_lili_l_10(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1098, args, 4); }
+// static int64_t svm_trampoline_lili_l_11(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1099, args, 4); }
+// static int64_t svm_trampoline_lili_l_12(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1100, args, 4); }
+// static int64_t svm_trampoline_lili_l_13(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1101, args, 4); }
+// static int64_t svm_trampoline_lili_l_14(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1102, args, 4); }
+// static int64_t svm_trampoline_lili_l_15(void *ctx, int64_t a0, int32_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1103, args, 4); }
+// static void svm_trampoline_lill_v_0(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1104, args, 4); }
+// static void svm_trampoline_lill_v_1(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1105, args, 4); }
+// static void svm_trampoline_lill_v_2(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1106, args, 4); }
+// static void svm_trampoline_lill_v_3(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1107, args, 4); }
+// static void svm_trampoline_lill_v_4(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1108, args, 4); }
+// static void svm_trampoline_lill_v_5(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1109, args, 4); }
+// static void svm_trampoline_lill_v_6(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1110, args, 4); }
+// static void svm_trampoline_lill_v_7(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1111, args, 4); }
+// static void svm_trampoline_lill_v_8(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1112, args, 4); }
+// static void svm_trampoline_lill_v_9(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1113, args, 4); }
+// static void svm_trampoline_lill_v_10(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1114, args, 4); }
+// static void svm_trampoline_lill_v_11(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1115, args, 4); }
+// static void svm_trampoline_lill_v_12(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1116, args, 4); }
+// static void svm_trampoline_lill_v_13(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1117, args, 4); }
+// static void svm_trampoline_lill_v_14(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1118, args, 4); }
+// static void svm_trampoline_lill_v_15(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1119, args, 4); }
+// static int32_t svm_trampoline_lill_i_0(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1120, args, 4); }
+// static int32_t svm_trampoline_lill_i_1(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1121, args, 4); }
+// static int32_t svm_trampoline_lill_i_2(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1122, args, 4); }
+// static int32_t svm_trampoline_lill_i_3(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1123, args, 4); }
+// static int32_t svm_trampoline_lill_i_4(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1124, args, 4); }
+// static int32_t svm_trampoline_lill_i_5(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1125, args, 4); }
+// static int32_t svm_trampoline_lill_i_6(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1126, args, 4); }
+// static int32_t svm_trampoline_lill_i_7(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1127, args, 4); }
+// static int32_t svm_trampoline_lill_i_8(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1128, args, 4); }
+// static int32_t svm_trampoline_lill_i_9(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1129, args, 4); }
+// static int32_t svm_trampoline_lill_i_10(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1130, args, 4); }
+// static int32_t svm_trampoline_lill_i_11(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1131, args, 4); }
+// static int32_t svm_trampoline_lill_i_12(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1132, args, 4); }
+// static int32_t svm_trampoline_lill_i_13(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1133, args, 4); }
+// static int32_t svm_trampoline_lill_i_14(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1134, args, 4); }
+// static int32_t svm_trampoline_lill_i_15(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1135, args, 4); }
+// static int64_t svm_trampoline_lill_l_0(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1136, args, 4); }
+// static int64_t svm_trampoline_lill_l_1(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1137, args, 4); }
+// static int64_t svm_trampoline_lill_l_2(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1138, args, 4); }
+// static int64_t svm_trampoline_lill_l_3(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1139, args, 4); }
+// static int64_t svm_trampoline_lill_l_4(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1140, args, 4); }
+// static int64_t svm_trampoline_lill_l_5(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1141, args, 4); }
+// static int64_t svm_trampoline_lill_l_6(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1142, args, 4); }
+// static int64_t svm_trampoline_lill_l_7(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1143, args, 4); }
+// static int64_t svm_trampoline_lill_l_8(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1144, args, 4); }
+// static int64_t svm_trampoline_lill_l_9(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1145, args, 4); }
+// static int64_t svm_trampoline_lill_l_10(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1146, args, 4); }
+// static int64_t svm_trampoline_lill_l_11(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1147, args, 4); }
+// static int64_t svm_trampoline_lill_l_12(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1148, args, 4); }
+// static int64_t svm_trampoline_lill_l_13(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1149, args, 4); }
+// static int64_t svm_trampoline_lill_l_14(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1150, args, 4); }
+// static int64_t svm_trampoline_lill_l_15(void *ctx, int64_t a0, int32_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1151, args, 4); }
+// static void svm_trampoline_ll_v_0(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1152, args, 2); }
+// static void svm_trampoline_ll_v_1(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1153, args, 2); }
+// static void svm_trampoline_ll_v_2(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1154, args, 2); }
+// static void svm_trampoline_ll_v_3(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1155, args, 2); }
+// static void svm_trampoline_ll_v_4(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1156, args, 2); }
+// static void svm_trampoline_ll_v_5(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1157, args, 2); }
+// static void svm_trampoline_ll_v_6(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1158, args, 2); }
+// static void svm_trampoline_ll_v_7(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1159, args, 2); }
+// static void svm_trampoline_ll_v_8(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1160, args, 2); }
+// static void svm_trampoline_ll_v_9(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1161, args, 2); }
+// static void svm_trampoline_ll_v_10(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1162, args, 2); }
+// static void svm_trampoline_ll_v_11(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1163, args, 2); }
+// static void svm_trampoline_ll_v_12(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1164, args, 2); }
+// static void svm_trampoline_ll_v_13(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1165, args, 2); }
+// static void svm_trampoline_ll_v_14(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1166, args, 2); }
+// static void svm_trampoline_ll_v_15(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; svm_import_dispatch(ctx, 1167, args, 2); }
+// static int32_t svm_trampoline_ll_i_0(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1168, args, 2); }
+// static int32_t svm_trampoline_ll_i_1(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1169, args, 2); }
+// static int32_t svm_trampoline_ll_i_2(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1170, args, 2); }
+// static int32_t svm_trampoline_ll_i_3(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1171, args, 2); }
+// static int32_t svm_trampoline_ll_i_4(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1172, args, 2); }
+// static int32_t svm_trampoline_ll_i_5(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1173, args, 2); }
+// static int32_t svm_trampoline_ll_i_6(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1174, args, 2); }
+// static int32_t svm_trampoline_ll_i_7(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1175, args, 2); }
+// static int32_t svm_trampoline_ll_i_8(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1176, args, 2); }
+// static int32_t svm_trampoline_ll_i_9(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1177, args, 2); }
+// static int32_t svm_trampoline_ll_i_10(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1178, args, 2); }
+// static int32_t svm_trampoline_ll_i_11(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1179, args, 2); }
+// static int32_t svm_trampoline_ll_i_12(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1180, args, 2); }
+// static int32_t svm_trampoline_ll_i_13(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1181, args, 2); }
+// static int32_t svm_trampoline_ll_i_14(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1182, args, 2); }
+// static int32_t svm_trampoline_ll_i_15(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int32_t)svm_import_dispatch(ctx, 1183, args, 2); }
+// static int64_t svm_trampoline_ll_l_0(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1184, args, 2); }
+// static int64_t svm_trampoline_ll_l_1(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1185, args, 2); }
+// static int64_t svm_trampoline_ll_l_2(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1186, args, 2); }
+// static int64_t svm_trampoline_ll_l_3(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1187, args, 2); }
+// static int64_t svm_trampoline_ll_l_4(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1188, args, 2); }
+// static int64_t svm_trampoline_ll_l_5(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1189, args, 2); }
+// static int64_t svm_trampoline_ll_l_6(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1190, args, 2); }
+// static int64_t svm_trampoline_ll_l_7(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1191, args, 2); }
+// static int64_t svm_trampoline_ll_l_8(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1192, args, 2); }
+// static int64_t svm_trampoline_ll_l_9(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1193, args, 2); }
+// static int64_t svm_trampoline_ll_l_10(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1194, args, 2); }
+// static int64_t svm_trampoline_ll_l_11(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1195, args, 2); }
+// static int64_t svm_trampoline_ll_l_12(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1196, args, 2); }
+// static int64_t svm_trampoline_ll_l_13(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1197, args, 2); }
+// static int64_t svm_trampoline_ll_l_14(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1198, args, 2); }
+// static int64_t svm_trampoline_ll_l_15(void *ctx, int64_t a0, int64_t a1) { int64_t args[2] = {a0, a1}; return (int64_t)svm_import_dispatch(ctx, 1199, args, 2); }
+// static void svm_trampoline_lli_v_0(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1200, args, 3); }
+// static void svm_trampoline_lli_v_1(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1201, args, 3); }
+// static void svm_trampoline_lli_v_2(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1202, args, 3); }
+// static void svm_trampoline_lli_v_3(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1203, args, 3); }
+// static void svm_trampoline_lli_v_4(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1204, args, 3); }
+// static void svm_trampoline_lli_v_5(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1205, args, 3); }
+// static void svm_trampoline_lli_v_6(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1206, args, 3); }
+// static void svm_trampoline_lli_v_7(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1207, args, 3); }
+// static void svm_trampoline_lli_v_8(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1208, args, 3); }
+// static void svm_trampoline_lli_v_9(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1209, args, 3); }
+// static void svm_trampoline_lli_v_10(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1210, args, 3); }
+// static void svm_trampoline_lli_v_11(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1211, args, 3); }
+// static void svm_trampoline_lli_v_12(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1212, args, 3); }
+// static void svm_trampoline_lli_v_13(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1213, args, 3); }
+// static void svm_trampoline_lli_v_14(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1214, args, 3); }
+// static void svm_trampoline_lli_v_15(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1215, args, 3); }
+// static int32_t svm_trampoline_lli_i_0(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1216, args, 3); }
+// static int32_t svm_trampoline_lli_i_1(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1217, args, 3); }
+// static int32_t svm_trampoline_lli_i_2(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1218, args, 3); }
+// static int32_t svm_trampoline_lli_i_3(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1219, args, 3); }
+// static int32_t svm_trampoline_lli_i_4(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1220, args, 3); }
+// static int32_t svm_trampoline_lli_i_5(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1221, args, 3); }
+// static int32_t svm_trampoline_lli_i_6(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1222, args, 3); }
+// static int32_t svm_trampoline_lli_i_7(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1223, args, 3); }
+// static int32_t svm_trampoline_lli_i_8(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1224, args, 3); }
+// static int32_t svm_trampoline_lli_i_9(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1225, args, 3); }
+// static int32_t svm_trampoline_lli_i_10(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1226, args, 3); }
+// static int32_t svm_trampoline_lli_i_11(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1227, args, 3); }
+// static int32_t svm_trampoline_lli_i_12(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1228, args, 3); }
+// static int32_t svm_trampoline_lli_i_13(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1229, args, 3); }
+// static int32_t svm_trampoline_lli_i_14(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1230, args, 3); }
+// static int32_t svm_trampoline_lli_i_15(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1231, args, 3); }
+// static int64_t svm_trampoline_lli_l_0(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1232, args, 3); }
+// static int64_t svm_trampoline_lli_l_1(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1233, args, 3); }
+// static int64_t svm_trampoline_lli_l_2(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1234, args, 3); }
+// static int64_t svm_trampoline_lli_l_3(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1235, args, 3); }
+// static int64_t svm_trampoline_lli_l_4(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1236, args, 3); }
+// static int64_t svm_trampoline_lli_l_5(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1237, args, 3); }
+// static int64_t svm_trampoline_lli_l_6(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1238, args, 3); }
+// static int64_t svm_trampoline_lli_l_7(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1239, args, 3); }
+// static int64_t svm_trampoline_lli_l_8(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1240, args, 3); }
+// static int64_t svm_trampoline_lli_l_9(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1241, args, 3); }
+// static int64_t svm_trampoline_lli_l_10(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1242, args, 3); }
+// static int64_t svm_trampoline_lli_l_11(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1243, args, 3); }
+// static int64_t svm_trampoline_lli_l_12(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1244, args, 3); }
+// static int64_t svm_trampoline_lli_l_13(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1245, args, 3); }
+// static int64_t svm_trampoline_lli_l_14(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1246, args, 3); }
+// static int64_t svm_trampoline_lli_l_15(void *ctx, int64_t a0, int64_t a1, int32_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1247, args, 3); }
+// static void svm_trampoline_llii_v_0(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1248, args, 4); }
+// static void svm_trampoline_llii_v_1(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1249, args, 4); }
+// static void svm_trampoline_llii_v_2(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1250, args, 4); }
+// static void svm_trampoline_llii_v_3(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1251, args, 4); }
+// static void svm_trampoline_llii_v_4(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1252, args, 4); }
+// static void svm_trampoline_llii_v_5(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1253, args, 4); }
+// static void svm_trampoline_llii_v_6(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1254, args, 4); }
+// static void svm_trampoline_llii_v_7(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1255, args, 4); }
+// static void svm_trampoline_llii_v_8(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1256, args, 4); }
+// static void svm_trampoline_llii_v_9(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1257, args, 4); }
+// static void svm_trampoline_llii_v_10(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1258, args, 4); }
+// static void svm_trampoline_llii_v_11(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1259, args, 4); }
+// static void svm_trampoline_llii_v_12(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1260, args, 4); }
+// static void svm_trampoline_llii_v_13(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1261, args, 4); }
+// static void svm_trampoline_llii_v_14(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1262, args, 4); }
+// static void svm_trampoline_llii_v_15(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1263, args, 4); }
+// static int32_t svm_trampoline_llii_i_0(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1264, args, 4); }
+// static int32_t svm_trampoline_llii_i_1(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1265, args, 4); }
+// static int32_t svm_trampoline_llii_i_2(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1266, args, 4); }
+// static int32_t svm_trampoline_llii_i_3(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1267, args, 4); }
+// static int32_t svm_trampoline_llii_i_4(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1268, args, 4); }
+// static int32_t svm_trampoline_llii_i_5(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1269, args, 4); }
+// static int32_t svm_trampoline_llii_i_6(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1270, args, 4); }
+// static int32_t svm_trampoline_llii_i_7(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1271, args, 4); }
+// static int32_t svm_trampoline_llii_i_8(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1272, args, 4); }
+// static int32_t svm_trampoline_llii_i_9(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1273, args, 4); }
+// static int32_t svm_trampoline_llii_i_10(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1274, args, 4); }
+// static int32_t svm_trampoline_llii_i_11(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1275, args, 4); }
+// static int32_t svm_trampoline_llii_i_12(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1276, args, 4); }
+// static int32_t svm_trampoline_llii_i_13(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1277, args, 4); }
+// static int32_t svm_trampoline_llii_i_14(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1278, args, 4); }
+// static int32_t svm_trampoline_llii_i_15(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1279, args, 4); }
+// static int64_t svm_trampoline_llii_l_0(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1280, args, 4); }
+// static int64_t svm_trampoline_llii_l_1(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1281, args, 4); }
+// static int64_t svm_trampoline_llii_l_2(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1282, args, 4); }
+// static int64_t svm_trampoline_llii_l_3(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1283, args, 4); }
+// static int64_t svm_trampoline_llii_l_4(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1284, args, 4); }
+// static int64_t svm_trampoline_llii_l_5(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1285, args, 4); }
+// static int64_t svm_trampoline_llii_l_6(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1286, args, 4); }
+// static int64_t svm_trampoline_llii_l_7(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1287, args, 4); }
+// static int64_t svm_trampoline_llii_l_8(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1288, args, 4); }
+// static int64_t svm_trampoline_llii_l_9(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1289, args, 4); }
+// static int64_t svm_trampoline_llii_l_10(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1290, args, 4); }
+// static int64_t svm_trampoline_llii_l_11(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1291, args, 4); }
+// static int64_t svm_trampoline_llii_l_12(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1292, args, 4); }
+// static int64_t svm_trampoline_llii_l_13(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1293, args, 4); }
+// static int64_t svm_trampoline_llii_l_14(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1294, args, 4); }
+// static int64_t svm_trampoline_llii_l_15(void *ctx, int64_t a0, int64_t a1, int32_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1295, args, 4); }
+// static void svm_trampoline_llil_v_0(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1296, args, 4); }
+// static void svm_trampoline_llil_v_1(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1297, args, 4); }
+// static void svm_trampoline_llil_v_2(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1298, args, 4); }
+// static void svm_trampoline_llil_v_3(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1299, args, 4); }
+// static void svm_trampoline_llil_v_4(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1300, args, 4); }
+// static void svm_trampoline_llil_v_5(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1301, args, 4); }
+// static void svm_trampoline_llil_v_6(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1302, args, 4); }
+// static void svm_trampoline_llil_v_7(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1303, args, 4); }
+// static void svm_trampoline_llil_v_8(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1304, args, 4); }
+// static void svm_trampoline_llil_v_9(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1305, args, 4); }
+// static void svm_trampoline_llil_v_10(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1306, args, 4); }
+// static void svm_trampoline_llil_v_11(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1307, args, 4); }
+// static void svm_trampoline_llil_v_12(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1308, args, 4); }
+// static void svm_trampoline_llil_v_13(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1309, args, 4); }
+// static void svm_trampoline_llil_v_14(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1310, args, 4); }
+// static void svm_trampoline_llil_v_15(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1311, args, 4); }
+// static int32_t svm_trampoline_llil_i_0(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1312, args, 4); }
+// static int32_t svm_trampoline_llil_i_1(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1313, args, 4); }
+// static int32_t svm_trampoline_llil_i_2(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1314, args, 4); }
+// static int32_t svm_trampoline_llil_i_3(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1315, args, 4); }
+// static int32_t svm_trampoline_llil_i_4(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1316, args, 4); }
+// static int32_t svm_trampoline_llil_i_5(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1317, args, 4); }
+// static int32_t svm_trampoline_llil_i_6(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1318, args, 4); }
+// static int32_t svm_trampoline_llil_i_7(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1319, args, 4); }
+// static int32_t svm_trampoline_llil_i_8(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1320, args, 4); }
+// static int32_t svm_trampoline_llil_i_9(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1321, args, 4); }
+// static int32_t svm_trampoline_llil_i_10(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1322, args, 4); }
+// static int32_t svm_trampoline_llil_i_11(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1323, args, 4); }
+// static int32_t svm_trampoline_llil_i_12(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1324, args, 4); }
+// static int32_t svm_trampoline_llil_i_13(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1325, args, 4); }
+// static int32_t svm_trampoline_llil_i_14(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1326, args, 4); }
+// static int32_t svm_trampoline_llil_i_15(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1327, args, 4); }
+// static int64_t svm_trampoline_llil_l_0(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1328, args, 4); }
+// static int64_t svm_trampoline_llil_l_1(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1329, args, 4); }
+// static int64_t svm_trampoline_llil_l_2(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1330, args, 4); }
+// static int64_t svm_trampoline_llil_l_3(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1331, args, 4); }
+// static int64_t svm_trampoline_llil_l_4(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1332, args, 4); }
+// static int64_t svm_trampoline_llil_l_5(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1333, args, 4); }
+// static int64_t svm_trampoline_llil_l_6(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1334, args, 4); }
+// static int64_t svm_trampoline_llil_l_7(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1335, args, 4); }
+// static int64_t svm_trampoline_llil_l_8(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1336, args, 4); }
+// static int64_t svm_trampoline_llil_l_9(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1337, args, 4); }
+// static int64_t svm_trampoline_llil_l_10(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1338, args, 4); }
+// static int64_t svm_trampoline_llil_l_11(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1339, args, 4); }
+// static int64_t svm_trampoline_llil_l_12(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1340, args, 4); }
+// static int64_t svm_trampoline_llil_l_13(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1341, args, 4); }
+// static int64_t svm_trampoline_llil_l_14(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1342, args, 4); }
+// static int64_t svm_trampoline_llil_l_15(void *ctx, int64_t a0, int64_t a1, int32_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1343, args, 4); }
+// static void svm_trampoline_lll_v_0(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1344, args, 3); }
+// static void svm_trampoline_lll_v_1(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1345, args, 3); }
+// static void svm_trampoline_lll_v_2(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1346, args, 3); }
+// static void svm_trampoline_lll_v_3(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1347, args, 3); }
+// static void svm_trampoline_lll_v_4(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1348, args, 3); }
+// static void svm_trampoline_lll_v_5(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1349, args, 3); }
+// static void svm_trampoline_lll_v_6(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1350, args, 3); }
+// static void svm_trampoline_lll_v_7(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1351, args, 3); }
+// static void svm_trampoline_lll_v_8(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1352, args, 3); }
+// static void svm_trampoline_lll_v_9(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1353, args, 3); }
+// static void svm_trampoline_lll_v_10(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1354, args, 3); }
+// static void svm_trampoline_lll_v_11(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1355, args, 3); }
+// static void svm_trampoline_lll_v_12(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1356, args, 3); }
+// static void svm_trampoline_lll_v_13(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1357, args, 3); }
+// static void svm_trampoline_lll_v_14(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1358, args, 3); }
+// static void svm_trampoline_lll_v_15(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; svm_import_dispatch(ctx, 1359, args, 3); }
+// static int32_t svm_trampoline_lll_i_0(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1360, args, 3); }
+// static int32_t svm_trampoline_lll_i_1(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1361, args, 3); }
+// static int32_t svm_trampoline_lll_i_2(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1362, args, 3); }
+// static int32_t svm_trampoline_lll_i_3(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1363, args, 3); }
+// static int32_t svm_trampoline_lll_i_4(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1364, args, 3); }
+// static int32_t svm_trampoline_lll_i_5(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1365, args, 3); }
+// static int32_t svm_trampoline_lll_i_6(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1366, args, 3); }
+// static int32_t svm_trampoline_lll_i_7(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1367, args, 3); }
+// static int32_t svm_trampoline_lll_i_8(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1368, args, 3); }
+// static int32_t svm_trampoline_lll_i_9(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1369, args, 3); }
+// static int32_t svm_trampoline_lll_i_10(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1370, args, 3); }
+// static int32_t svm_trampoline_lll_i_11(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1371, args, 3); }
+// static int32_t svm_trampoline_lll_i_12(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1372, args, 3); }
+// static int32_t svm_trampoline_lll_i_13(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1373, args, 3); }
+// static int32_t svm_trampoline_lll_i_14(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1374, args, 3); }
+// static int32_t svm_trampoline_lll_i_15(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int32_t)svm_import_dispatch(ctx, 1375, args, 3); }
+// static int64_t svm_trampoline_lll_l_0(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1376, args, 3); }
+// static int64_t svm_trampoline_lll_l_1(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1377, args, 3); }
+// static int64_t svm_trampoline_lll_l_2(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1378, args, 3); }
+// static int64_t svm_trampoline_lll_l_3(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1379, args, 3); }
+// static int64_t svm_trampoline_lll_l_4(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1380, args, 3); }
+// static int64_t svm_trampoline_lll_l_5(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1381, args, 3); }
+// static int64_t svm_trampoline_lll_l_6(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1382, args, 3); }
+// static int64_t svm_trampoline_lll_l_7(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1383, args, 3); }
+// static int64_t svm_trampoline_lll_l_8(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1384, args, 3); }
+// static int64_t svm_trampoline_lll_l_9(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1385, args, 3); }
+// static int64_t svm_trampoline_lll_l_10(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1386, args, 3); }
+// static int64_t svm_trampoline_lll_l_11(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1387, args, 3); }
+// static int64_t svm_trampoline_lll_l_12(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1388, args, 3); }
+// static int64_t svm_trampoline_lll_l_13(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1389, args, 3); }
+// static int64_t svm_trampoline_lll_l_14(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1390, args, 3); }
+// static int64_t svm_trampoline_lll_l_15(void *ctx, int64_t a0, int64_t a1, int64_t a2) { int64_t args[3] = {a0, a1, a2}; return (int64_t)svm_import_dispatch(ctx, 1391, args, 3); }
+// static void svm_trampoline_llli_v_0(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1392, args, 4); }
+// static void svm_trampoline_llli_v_1(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1393, args, 4); }
+// static void svm_trampoline_llli_v_2(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1394, args, 4); }
+// static void svm_trampoline_llli_v_3(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1395, args, 4); }
+// static void svm_trampoline_llli_v_4(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1396, args, 4); }
+// static void svm_trampoline_llli_v_5(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1397, args, 4); }
+// static void svm_trampoline_llli_v_6(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1398, args, 4); }
+// static void svm_trampoline_llli_v_7(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1399, args, 4); }
+// static void svm_trampoline_llli_v_8(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1400, args, 4); }
+// static void svm_trampoline_llli_v_9(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1401, args, 4); }
+// static void svm_trampoline_llli_v_10(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1402, args, 4); }
+// static void svm_trampoline_llli_v_11(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1403, args, 4); }
+// static void svm_trampoline_llli_v_12(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1404, args, 4); }
+// static void svm_trampoline_llli_v_13(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1405, args, 4); }
+// static void svm_trampoline_llli_v_14(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1406, args, 4); }
+// static void svm_trampoline_llli_v_15(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1407, args, 4); }
+// static int32_t svm_trampoline_llli_i_0(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1408, args, 4); }
+// static int32_t svm_trampoline_llli_i_1(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1409, args, 4); }
+// static int32_t svm_trampoline_llli_i_2(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1410, args, 4); }
+// static int32_t svm_trampoline_llli_i_3(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1411, args, 4); }
+// static int32_t svm_trampoline_llli_i_4(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1412, args, 4); }
+// static int32_t svm_trampoline_llli_i_5(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1413, args, 4); }
+// static int32_t svm_trampoline_llli_i_6(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1414, args, 4); }
+// static int32_t svm_trampoline_llli_i_7(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1415, args, 4); }
+// static int32_t svm_trampoline_llli_i_8(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1416, args, 4); }
+// static int32_t svm_trampoline_llli_i_9(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1417, args, 4); }
+// static int32_t svm_trampoline_llli_i_10(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1418, args, 4); }
+// static int32_t svm_trampoline_llli_i_11(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1419, args, 4); }
+// static int32_t svm_trampoline_llli_i_12(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1420, args, 4); }
+// static int32_t svm_trampoline_llli_i_13(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1421, args, 4); }
+// static int32_t svm_trampoline_llli_i_14(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1422, args, 4); }
+// static int32_t svm_trampoline_llli_i_15(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1423, args, 4); }
+// static int64_t svm_trampoline_llli_l_0(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1424, args, 4); }
+// static int64_t svm_trampoline_llli_l_1(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1425, args, 4); }
+// static int64_t svm_trampoline_llli_l_2(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1426, args, 4); }
+// static int64_t svm_trampoline_llli_l_3(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1427, args, 4); }
+// static int64_t svm_trampoline_llli_l_4(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1428, args, 4); }
+// static int64_t svm_trampoline_llli_l_5(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1429, args, 4); }
+// static int64_t svm_trampoline_llli_l_6(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1430, args, 4); }
+// static int64_t svm_trampoline_llli_l_7(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1431, args, 4); }
+// static int64_t svm_trampoline_llli_l_8(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1432, args, 4); }
+// static int64_t svm_trampoline_llli_l_9(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1433, args, 4); }
+// static int64_t svm_trampoline_llli_l_10(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1434, args, 4); }
+// static int64_t svm_trampoline_llli_l_11(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1435, args, 4); }
+// static int64_t svm_trampoline_llli_l_12(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1436, args, 4); }
+// static int64_t svm_trampoline_llli_l_13(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1437, args, 4); }
+// static int64_t svm_trampoline_llli_l_14(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1438, args, 4); }
+// static int64_t svm_trampoline_llli_l_15(void *ctx, int64_t a0, int64_t a1, int64_t a2, int32_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1439, args, 4); }
+// static void svm_trampoline_llll_v_0(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1440, args, 4); }
+// static void svm_trampoline_llll_v_1(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1441, args, 4); }
+// static void svm_trampoline_llll_v_2(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1442, args, 4); }
+// static void svm_trampoline_llll_v_3(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1443, args, 4); }
+// static void svm_trampoline_llll_v_4(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1444, args, 4); }
+// static void svm_trampoline_llll_v_5(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1445, args, 4); }
+// static void svm_trampoline_llll_v_6(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1446, args, 4); }
+// static void svm_trampoline_llll_v_7(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1447, args, 4); }
+// static void svm_trampoline_llll_v_8(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1448, args, 4); }
+// static void svm_trampoline_llll_v_9(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1449, args, 4); }
+// static void svm_trampoline_llll_v_10(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1450, args, 4); }
+// static void svm_trampoline_llll_v_11(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1451, args, 4); }
+// static void svm_trampoline_llll_v_12(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1452, args, 4); }
+// static void svm_trampoline_llll_v_13(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1453, args, 4); }
+// static void svm_trampoline_llll_v_14(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1454, args, 4); }
+// static void svm_trampoline_llll_v_15(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; svm_import_dispatch(ctx, 1455, args, 4); }
+// static int32_t svm_trampoline_llll_i_0(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1456, args, 4); }
+// static int32_t svm_trampoline_llll_i_1(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1457, args, 4); }
+// static int32_t svm_trampoline_llll_i_2(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1458, args, 4); }
+// static int32_t svm_trampoline_llll_i_3(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1459, args, 4); }
+// static int32_t svm_trampoline_llll_i_4(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1460, args, 4); }
+// static int32_t svm_trampoline_llll_i_5(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1461, args, 4); }
+// static int32_t svm_trampoline_llll_i_6(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1462, args, 4); }
+// static int32_t svm_trampoline_llll_i_7(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1463, args, 4); }
+// static int32_t svm_trampoline_llll_i_8(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1464, args, 4); }
+// static int32_t svm_trampoline_llll_i_9(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1465, args, 4); }
+// static int32_t svm_trampoline_llll_i_10(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1466, args, 4); }
+// static int32_t svm_trampoline_llll_i_11(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1467, args, 4); }
+// static int32_t svm_trampoline_llll_i_12(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1468, args, 4); }
+// static int32_t svm_trampoline_llll_i_13(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1469, args, 4); }
+// static int32_t svm_trampoline_llll_i_14(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1470, args, 4); }
+// static int32_t svm_trampoline_llll_i_15(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int32_t)svm_import_dispatch(ctx, 1471, args, 4); }
+// static int64_t svm_trampoline_llll_l_0(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1472, args, 4); }
+// static int64_t svm_trampoline_llll_l_1(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1473, args, 4); }
+// static int64_t svm_trampoline_llll_l_2(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1474, args, 4); }
+// static int64_t svm_trampoline_llll_l_3(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1475, args, 4); }
+// static int64_t svm_trampoline_llll_l_4(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1476, args, 4); }
+// static int64_t svm_trampoline_llll_l_5(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1477, args, 4); }
+// static int64_t svm_trampoline_llll_l_6(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1478, args, 4); }
+// static int64_t svm_trampoline_llll_l_7(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1479, args, 4); }
+// static int64_t svm_trampoline_llll_l_8(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1480, args, 4); }
+// static int64_t svm_trampoline_llll_l_9(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1481, args, 4); }
+// static int64_t svm_trampoline_llll_l_10(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1482, args, 4); }
+// static int64_t svm_trampoline_llll_l_11(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1483, args, 4); }
+// static int64_t svm_trampoline_llll_l_12(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1484, args, 4); }
+// static int64_t svm_trampoline_llll_l_13(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1485, args, 4); }
+// static int64_t svm_trampoline_llll_l_14(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1486, args, 4); }
+// static int64_t svm_trampoline_llll_l_15(void *ctx, int64_t a0, int64_t a1, int64_t a2, int64_t a3) { int64_t args[4] = {a0, a1, a2, a3}; return (int64_t)svm_import_dispatch(ctx, 1487, args, 4); }
+//
+// static void *svm_trampolines[] = {
+//   (void *)svm_trampoline_v_v_0,
+//   (void *)svm_trampoline_v_v_1,
+//   (void *)svm_trampoline_v_v_2,
+//   (void *)svm_trampoline_v_v_3,
+//   (void *)svm_trampoline_v_v_4,
+//   (void *)svm_trampoline_v_v_5,
+//   (void *)svm_trampoline_v_v_6,
+//   (void *)svm_trampoline_v_v_7,
+//   (void *)svm_trampoline_v_v_8,
+//   (void *)svm_trampoline_v_v_9,
+//   (void *)svm_trampoline_v_v_10,
+//   (void *)svm_trampoline_v_v_11,
+//   (void *)svm_trampoline_v_v_12,
+//   (void *)svm_trampoline_v_v_13,
+//   (void *)svm_trampoline_v_v_14,
+//   (void *)svm_trampoline_v_v_15,
+//   (void *)svm_trampoline_v_i_0,
+//   (void *)svm_trampoline_v_i_1,
+//   (void *)svm_trampoline_v_i_2,
+//   (void *)svm_trampoline_v_i_3,
+//   (void *)svm_trampoline_v_i_4,
+//   (void *)svm_trampoline_v_i_5,
+//   (void *)svm_trampoline_v_i_6,
+//   (void *)svm_trampoline_v_i_7,
+//   (void *)svm_trampoline_v_i_8,
+//   (void *)svm_trampoline_v_i_9,
+//   (void *)svm_trampoline_v_i_10,
+//   (void *)svm_trampoline_v_i_11,
+//   (void *)svm_trampoline_v_i_12,
+//   (void *)svm_trampoline_v_i_13,
+//   (void *)svm_trampoline_v_i_14,
+//   (void *)svm_trampoline_v_i_15,
+//   (void *)svm_trampoline_v_l_0,
+//   (void *)svm_trampoline_v_l_1,
+//   (void *)svm_trampoline_v_l_2,
+//   (void *)svm_trampoline_v_l_3,
+//   (void *)svm_trampoline_v_l_4,
+//   (void *)svm_trampoline_v_l_5,
+//   (void *)svm_trampoline_v_l_6,
+//   (void *)svm_trampoline_v_l_7,
+//   (void *)svm_trampoline_v_l_8,
+//   (void *)svm_trampoline_v_l_9,
+//   (void *)svm_trampoline_v_l_10,
+//   (void *)svm_trampoline_v_l_11,
+//   (void *)svm_trampoline_v_l_12,
+//   (void *)svm_trampoline_v_l_13,
+//   (void *)svm_trampoline_v_l_14,
+//   (void *)svm_trampoline_v_l_15,
+//   (void *)svm_trampoline_i_v_0,
+//   (void *)svm_trampoline_i_v_1,
+//   (void *)svm_trampoline_i_v_2,
+//   (void *)svm_trampoline_i_v_3,
+//   (void *)svm_trampoline_i_v_4,
+//   (void *)svm_trampoline_i_v_5,
+//   (void *)svm_trampoline_i_v_6,
+//   (void *)svm_trampoline_i_v_7,
+//   (void *)svm_trampoline_i_v_8,
+//   (void *)svm_trampoline_i_v_9,
+//   (void *)svm_trampoline_i_v_10,
+//   (void *)svm_trampoline_i_v_11,
+//   (void *)svm_trampoline_i_v_12,
+//   (void *)svm_trampoline_i_v_13,
+//   (void *)svm_trampoline_i_v_14,
+//   (void *)svm_trampoline_i_v_15,
+//   (void *)svm_trampoline_i_i_0,
+//   (void *)svm_trampoline_i_i_1,
+//   (void *)svm_trampoline_i_i_2,
+//   (void *)svm_trampoline_i_i_3,
+//   (void *)svm_trampoline_i_i_4,
+//   (void *)svm_trampoline_i_i_5,
+//   (void *)svm_trampoline_i_i_6,
+//   (void *)svm_trampoline_i_i_7,
+//   (void *)svm_trampoline_i_i_8,
+//   (void *)svm_trampoline_i_i_9,
+//   (void *)svm_trampoline_i_i_10,
+//   (void *)svm_trampoline_i_i_11,
+//   (void *)svm_trampoline_i_i_12,
+//   (void *)svm_trampoline_i_i_13,
+//   (void *)svm_trampoline_i_i_14,
+//   (void *)svm_trampoline_i_i_15,
+//   (void *)svm_trampoline_i_l_0,
+//   (void *)svm_trampoline_i_l_1,
+//   (void *)svm_trampoline_i_l_2,
+//   (void *)svm_trampoline_i_l_3,
+//   (void *)svm_trampoline_i_l_4,
+//   (void *)svm_trampoline_i_l_5,
+//   (void *)svm_trampoline_i_l_6,
+//   (void *)svm_trampoline_i_l_7,
+//   (void *)svm_trampoline_i_l_8,
+//   (void *)svm_trampoline_i_l_9,
+//   (void *)svm_trampoline_i_l_10,
+//   (void *)svm_trampoline_i_l_11,
+//   (void *)svm_trampoline_i_l_12,
+//   (void *)svm_trampoline_i_l_13,
+//   (void *)svm_trampoline_i_l_14,
+//   (void *)svm_trampoline_i_l_15,
+//   (void *)svm_trampoline_ii_v_0,
+//   (void *)svm_trampoline_ii_v_1,
+//   (void *)svm_trampoline_ii_v_2,
+//   (void *)svm_trampoline_ii_v_3,
+//   (void *)svm_trampoline_ii_v_4,
+//   (void *)svm_trampoline_ii_v_5,
+//   (void *)svm_trampoline_ii_v_6,
+//   (void *)svm_trampoline_ii_v_7,
+//   (void *)svm_trampoline_ii_v_8,
+//   (void *)svm_trampoline_ii_v_9,
+//   (void *)svm_trampoline_ii_v_10,
+//   (void *)svm_trampoline_ii_v_11,
+//   (void *)svm_trampoline_ii_v_12,
+//   (void *)svm_trampoline_ii_v_13,
+//   (void *)svm_trampoline_ii_v_14,
+//   (void *)svm_trampoline_ii_v_15,
+//   (void *)svm_trampoline_ii_i_0,
+//   (void *)svm_trampoline_ii_i_1,
+//   (void *)svm_trampoline_ii_i_2,
+//   (void *)svm_trampoline_ii_i_3,
+//   (void *)svm_trampoline_ii_i_4,
+//   (void *)svm_trampoline_ii_i_5,
+//   (void *)svm_trampoline_ii_i_6,
+//   (void *)svm_trampoline_ii_i_7,
+//   (void *)svm_trampoline_ii_i_8,
+//   (void *)svm_trampoline_ii_i_9,
+//   (void *)svm_trampoline_ii_i_10,
+//   (void *)svm_trampoline_ii_i_11,
+//   (void *)svm_trampoline_ii_i_12,
+//   (void *)svm_trampoline_ii_i_13,
+//   (void *)svm_trampoline_ii_i_14,
+//   (void *)svm_trampoline_ii_i_15,
+//   (void *)svm_trampoline_ii_l_0,
+//   (void *)svm_trampoline_ii_l_1,
+//   (void *)svm_trampoline_ii_l_2,
+//   (void *)svm_trampoline_ii_l_3,
+//   (void *)svm_trampoline_ii_l_4,
+//   (void *)svm_trampoline_ii_l_5,
+//   (void *)svm_trampoline_ii_l_6,
+//   (void *)svm_trampoline_ii_l_7,
+//   (void *)svm_trampoline_ii_l_8,
+//   (void *)svm_trampoline_ii_l_9,
+//   (void *)svm_trampoline_ii_l_10,
+//   (void *)svm_trampoline_ii_l_11,
+//   (void *)svm_trampoline_ii_l_12,
+//   (void *)svm_trampoline_ii_l_13,
+//   (void *)svm_trampoline_ii_l_14,
+//   (void *)svm_trampoline_ii_l_15,
+//   (void *)svm_trampoline_iii_v_0,
+//   (void *)svm_trampoline_iii_v_1,
+//   (void *)svm_trampoline_iii_v_2,
+//   (void *)svm_trampoline_iii_v_3,
+//   (void *)svm_trampoline_iii_v_4,
+//   (void *)svm_trampoline_iii_v_5,
+//   (void *)svm_trampoline_iii_v_6,
+//   (void *)svm_trampoline_iii_v_7,
+//   (void *)svm_trampoline_iii_v_8,
+//   (void *)svm_trampoline_iii_v_9,
+//   (void *)svm_trampoline_iii_v_10,
+//   (void *)svm_trampoline_iii_v_11,
+//   (void *)svm_trampoline_iii_v_12,
+//   (void *)svm_trampoline_iii_v_13,
+//   (void *)svm_trampoline_iii_v_14,
+//   (void *)svm_trampoline_iii_v_15,
+//   (void *)svm_trampoline_iii_i_0,
+//   (void *)svm_trampoline_iii_i_1,
+//   (void *)svm_trampoline_iii_i_2,
+//   (void *)svm_trampoline_iii_i_3,
+//   (void *)svm_trampoline_iii_i_4,
+//   (void *)svm_trampoline_iii_i_5,
+//   (void *)svm_trampoline_iii_i_6,
+//   (void *)svm_trampoline_iii_i_7,
+//   (void *)svm_trampoline_iii_i_8,
+//   (void *)svm_trampoline_iii_i_9,
+//   (void *)svm_trampoline_iii_i_10,
+//   (void *)svm_trampoline_iii_i_11,
+//   (void *)svm_trampoline_iii_i_12,
+//   (void *)svm_trampoline_iii_i_13,
+//   (void *)svm_trampoline_iii_i_14,
+//   (void *)svm_trampoline_iii_i_15,
+//   (void *)svm_trampoline_iii_l_0,
+//   (void *)svm_trampoline_iii_l_1,
+//   (void *)svm_trampoline_iii_l_2,
+//   (void *)svm_trampoline_iii_l_3,
+//   (void *)svm_trampoline_iii_l_4,
+//   (void *)svm_trampoline_iii_l_5,
+//   (void *)svm_trampoline_iii_l_6,
+//   (void *)svm_trampoline_iii_l_7,
+//   (void *)svm_trampoline_iii_l_8,
+//   (void *)svm_trampoline_iii_l_9,
+//   (void *)svm_trampoline_iii_l_10,
+//   (void *)svm_trampoline_iii_l_11,
+//   (void *)svm_trampoline_iii_l_12,
+//   (void *)svm_trampoline_iii_l_13,
+//   (void *)svm_trampoline_iii_l_14,
+//   (void *)svm_trampoline_iii_l_15,
+//   (void *)svm_trampoline_iiii_v_0,
+//   (void *)svm_trampoline_iiii_v_1,
+//   (void *)svm_trampoline_iiii_v_2,
+//   (void *)svm_trampoline_iiii_v_3,
+//   (void *)svm_trampoline_iiii_v_4,
+//   (void *)svm_trampoline_iiii_v_5,
+//   (void *)svm_trampoline_iiii_v_6,
+//   (void *)svm_trampoline_iiii_v_7,
+//   (void *)svm_trampoline_iiii_v_8,
+//   (void *)svm_trampoline_iiii_v_9,
+//   (void *)svm_trampoline_iiii_v_10,
+//   (void *)svm_trampoline_iiii_v_11,
+//   (void *)svm_trampoline_iiii_v_12,
+//   (void *)svm_trampoline_iiii_v_13,
+//   (void *)svm_trampoline_iiii_v_14,
+//   (void *)svm_trampoline_iiii_v_15,
+//   (void *)svm_trampoline_iiii_i_0,
+//   (void *)svm_trampoline_iiii_i_1,
+//   (void *)svm_trampoline_iiii_i_2,
+//   (void *)svm_trampoline_iiii_i_3,
+//   (void *)svm_trampoline_iiii_i_4,
+//   (void *)svm_trampoline_iiii_i_5,
+//   (void *)svm_trampoline_iiii_i_6,
+//   (void *)svm_trampoline_iiii_i_7,
+//   (void *)svm_trampoline_iiii_i_8,
+//   (void *)svm_trampoline_iiii_i_9,
+//   (void *)svm_trampoline_iiii_i_10,
+//   (void *)svm_trampoline_iiii_i_11,
+//   (void *)svm_trampoline_iiii_i_12,
+//   (void *)svm_trampoline_iiii_i_13,
+//   (void *)svm_trampoline_iiii_i_14,
+//   (void *)svm_trampoline_iiii_i_15,
+//   (void *)svm_trampoline_iiii_l_0,
+//   (void *)svm_trampoline_iiii_l_1,
+//   (void *)svm_trampoline_iiii_l_2,
+//   (void *)svm_trampoline_iiii_l_3,
+//   (void *)svm_trampoline_iiii_l_4,
+//   (void *)svm_trampoline_iiii_l_5,
+//   (void *)svm_trampoline_iiii_l_6,
+//   (void *)svm_trampoline_iiii_l_7,
+//   (void *)svm_trampoline_iiii_l_8,
+//   (void *)svm_trampoline_iiii_l_9,
+//   (void *)svm_trampoline_iiii_l_10,
+//   (void *)svm_trampoline_iiii_l_11,
+//   (void *)svm_trampoline_iiii_l_12,
+//   (void *)svm_trampoline_iiii_l_13,
+//   (void *)svm_trampoline_iiii_l_14,
+//   (void *)svm_trampoline_iiii_l_15,
+//   (void *)svm_trampoline_iiil_v_0,
+//   (void *)svm_trampoline_iiil_v_1,
+//   (void *)svm_trampoline_iiil_v_2,
+//   (void *)svm_trampoline_iiil_v_3,
+//   (void *)svm_trampoline_iiil_v_4,
+//   (void *)svm_trampoline_iiil_v_5,
+//   (void *)svm_trampoline_iiil_v_6,
+//   (void *)svm_trampoline_iiil_v_7,
+//   (void *)svm_trampoline_iiil_v_8,
+//   (void *)svm_trampoline_iiil_v_9,
+//   (void *)svm_trampoline_iiil_v_10,
+//   (void *)svm_trampoline_iiil_v_11,
+//   (void *)svm_trampoline_iiil_v_12,
+//   (void *)svm_trampoline_iiil_v_13,
+//   (void *)svm_trampoline_iiil_v_14,
+//   (void *)svm_trampoline_iiil_v_15,
+//   (void *)svm_trampoline_iiil_i_0,
+//   (void *)svm_trampoline_iiil_i_1,
+//   (void *)svm_trampoline_iiil_i_2,
+//   (void *)svm_trampoline_iiil_i_3,
+//   (void *)svm_trampoline_iiil_i_4,
+//   (void *)svm_trampoline_iiil_i_5,
+//   (void *)svm_trampoline_iiil_i_6,
+//   (void *)svm_trampoline_iiil_i_7,
+//   (void *)svm_trampoline_iiil_i_8,
+//   (void *)svm_trampoline_iiil_i_9,
+//   (void *)svm_trampoline_iiil_i_10,
+//   (void *)svm_trampoline_iiil_i_11,
+//   (void *)svm_trampoline_iiil_i_12,
+//   (void *)svm_trampoline_iiil_i_13,
+//   (void *)svm_trampoline_iiil_i_14,
+//   (void *)svm_trampoline_iiil_i_15,
+//   (void *)svm_trampoline_iiil_l_0,
+//   (void *)svm_trampoline_iiil_l_1,
+//   (void *)svm_trampoline_iiil_l_2,
+//   (void *)svm_trampoline_iiil_l_3,
+//   (void *)svm_trampoline_iiil_l_4,
+//   (void *)svm_trampoline_iiil_l_5,
+//   (void *)svm_trampoline_iiil_l_6,
+//   (void *)svm_trampoline_iiil_l_7,
+//   (void *)svm_trampoline_iiil_l_8,
+//   (void *)svm_trampoline_iiil_l_9,
+//   (void *)svm_trampoline_iiil_l_10,
+//   (void *)svm_trampoline_iiil_l_11,
+//   (void *)svm_trampoline_iiil_l_12,
+//   (void *)svm_trampoline_iiil_l_13,
+//   (void *)svm_trampoline_iiil_l_14,
+//   (void *)svm_trampoline_iiil_l_15,
+//   (void *)svm_trampoline_iil_v_0,
+//   (void *)svm_trampoline_iil_v_1,
+//   (void *)svm_trampoline_iil_v_2,
+//   (void *)svm_trampoline_iil_v_3,
+//   (void *)svm_trampoline_iil_v_4,
+//   (void *)svm_trampoline_iil_v_5,
+//   (void *)svm_trampoline_iil_v_6,
+//   (void *)svm_trampoline_iil_v_7,
+//   (void *)svm_trampoline_iil_v_8,
+//   (void *)svm_trampoline_iil_v_9,
+//   (void *)svm_trampoline_iil_v_10,
+//   (void *)svm_trampoline_iil_v_11,
+//   (void *)svm_trampoline_iil_v_12,
+//   (void *)svm_trampoline_iil_v_13,
+//   (void *)svm_trampoline_iil_v_14,
+//   (void *)svm_trampoline_iil_v_15,
+//   (void *)svm_trampoline_iil_i_0,
+//   (void *)svm_trampoline_iil_i_1,
+//   (void *)svm_trampoline_iil_i_2,
+//   (void *)svm_trampoline_iil_i_3,
+//   (void *)svm_trampoline_iil_i_4,
+//   (void *)svm_trampoline_iil_i_5,
+//   (void *)svm_trampoline_iil_i_6,
+//   (void *)svm_trampoline_iil_i_7,
+//   (void *)svm_trampoline_iil_i_8,
+//   (void *)svm_trampoline_iil_i_9,
+//   (void *)svm_trampoline_iil_i_10,
+//   (void *)svm_trampoline_iil_i_11,
+//   (void *)svm_trampoline_iil_i_12,
+//   (void *)svm_trampoline_iil_i_13,
+//   (void *)svm_trampoline_iil_i_14,
+//   (void *)svm_trampoline_iil_i_15,
+//   (void *)svm_trampoline_iil_l_0,
+//   (void *)svm_trampoline_iil_l_1,
+//   (void *)svm_trampoline_iil_l_2,
+//   (void *)svm_trampoline_iil_l_3,
+//   (void *)svm_trampoline_iil_l_4,
+//   (void *)svm_trampoline_iil_l_5,
+//   (void *)svm_trampoline_iil_l_6,
+//   (void *)svm_trampoline_iil_l_7,
+//   (void *)svm_trampoline_iil_l_8,
+//   (void *)svm_trampoline_iil_l_9,
+//   (void *)svm_trampoline_iil_l_10,
+//   (void *)svm_trampoline_iil_l_11,
+//   (void *)svm_trampoline_iil_l_12,
+//   (void *)svm_trampoline_iil_l_13,
+//   (void *)svm_trampoline_iil_l_14,
+//   (void *)svm_trampoline_iil_l_15,
+//   (void *)svm_trampoline_iili_v_0,
+//   (void *)svm_trampoline_iili_v_1,
+//   (void *)svm_trampoline_iili_v_2,
+//   (void *)svm_trampoline_iili_v_3,
+//   (void *)svm_trampoline_iili_v_4,
+//   (void *)svm_trampoline_iili_v_5,
+//   (void *)svm_trampoline_iili_v_6,
+//   (void *)svm_trampoline_iili_v_7,
+//   (void *)svm_trampoline_iili_v_8,
+//   (void *)svm_trampoline_iili_v_9,
+//   (void *)svm_trampoline_iili_v_10,
+//   (void *)svm_trampoline_iili_v_11,
+//   (void *)svm_trampoline_iili_v_12,
+//   (void *)svm_trampoline_iili_v_13,
+//   (void *)svm_trampoline_iili_v_14,
+//   (void *)svm_trampoline_iili_v_15,
+//   (void *)svm_trampoline_iili_i_0,
+//   (void *)svm_trampoline_iili_i_1,
+//   (void *)svm_trampoline_iili_i_2,
+//   (void *)svm_trampoline_iili_i_3,
+//   (void *)svm_trampoline_iili_i_4,
+//   (void *)svm_trampoline_iili_i_5,
+//   (void *)svm_trampoline_iili_i_6,
+//   (void *)svm_trampoline_iili_i_7,
+//   (void *)svm_trampoline_iili_i_8,
+//   (void *)svm_trampoline_iili_i_9,
+//   (void *)svm_trampoline_iili_i_10,
+//   (void *)svm_trampoline_iili_i_11,
+//   (void *)svm_trampoline_iili_i_12,
+//   (void *)svm_trampoline_iili_i_13,
+//   (void *)svm_trampoline_iili_i_14,
+//   (void *)svm_trampoline_iili_i_15,
+//   (void *)svm_trampoline_iili_l_0,
+//   (void *)svm_trampoline_iili_l_1,
+//   (void *)svm_trampoline_iili_l_2,
+//   (void *)svm_trampoline_iili_l_3,
+//   (void *)svm_trampoline_iili_l_4,
+//   (void *)svm_trampoline_iili_l_5,
+//   (void *)svm_trampoline_iili_l_6,
+//   (void *)svm_trampoline_iili_l_7,
+//   (void *)svm_trampoline_iili_l_8,
+//   (void *)svm_trampoline_iili_l_9,
+//   (void *)svm_trampoline_iili_l_10,
+//   (void *)svm_trampoline_iili_l_11,
+//   (void *)svm_trampoline_iili_l_12,
+//   (void *)svm_trampoline_iili_l_13,
+//   (void *)svm_trampoline_iili_l_14,
+//   (void *)svm_trampoline_iili_l_15,
+//   (void *)svm_trampoline_iill_v_0,
+//   (void *)svm_trampoline_iill_v_1,
+//   (void *)svm_trampoline_iill_v_2,
+//   (void *)svm_trampoline_iill_v_3,
+//   (void *)svm_trampoline_iill_v_4,
+//   (void *)svm_trampoline_iill_v_5,
+//   (void *)svm_trampoline_iill_v_6,
+//   (void *)svm_trampoline_iill_v_7,
+//   (void *)svm_trampoline_iill_v_8,
+//   (void *)svm_trampoline_iill_v_9,
+//   (void *)svm_trampoline_iill_v_10,
+//   (void *)svm_trampoline_iill_v_11,
+//   (void *)svm_trampoline_iill_v_12,
+//   (void *)svm_trampoline_iill_v_13,
+//   (void *)svm_trampoline_iill_v_14,
+//   (void *)svm_trampoline_iill_v_15,
+//   (void *)svm_trampoline_iill_i_0,
+//   (void *)svm_trampoline_iill_i_1,
+//   (void *)svm_trampoline_iill_i_2,
+//   (void *)svm_trampoline_iill_i_3,
+//   (void *)svm_trampoline_iill_i_4,
+//   (void *)svm_trampoline_iill_i_5,
+//   (void *)svm_trampoline_iill_i_6,
+//   (void *)svm_trampoline_iill_i_7,
+//   (void *)svm_trampoline_iill_i_8,
+//   (void *)svm_trampoline_iill_i_9,
+//   (void *)svm_trampoline_iill_i_10,
+//   (void *)svm_trampoline_iill_i_11,
+//   (void *)svm_trampoline_iill_i_12,
+//   (void *)svm_trampoline_iill_i_13,
+//   (void *)svm_trampoline_iill_i_14,
+//   (void *)svm_trampoline_iill_i_15,
+//   (void *)svm_trampoline_iill_l_0,
+//   (void *)svm_trampoline_iill_l_1,
+//   (void *)svm_trampoline_iill_l_2,
+//   (void *)svm_trampoline_iill_l_3,
+//   (void *)svm_trampoline_iill_l_4,
+//   (void *)svm_trampoline_iill_l_5,
+//   (void *)svm_trampoline_iill_l_6,
+//   (void *)svm_trampoline_iill_l_7,
+//   (void *)svm_trampoline_iill_l_8,
+//   (void *)svm_trampoline_iill_l_9,
+//   (void *)svm_trampoline_iill_l_10,
+//   (void *)svm_trampoline_iill_l_11,
+//   (void *)svm_trampoline_iill_l_12,
+//   (void *)svm_trampoline_iill_l_13,
+//   (void *)svm_trampoline_iill_l_14,
+//   (void *)svm_trampoline_iill_l_15,
+//   (void *)svm_trampoline_il_v_0,
+//   (void *)svm_trampoline_il_v_1,
+//   (void *)svm_trampoline_il_v_2,
+//   (void *)svm_trampoline_il_v_3,
+//   (void *)svm_trampoline_il_v_4,
+//   (void *)svm_trampoline_il_v_5,
+//   (void *)svm_trampoline_il_v_6,
+//   (void *)svm_trampoline_il_v_7,
+//   (void *)svm_trampoline_il_v_8,
+//   (void *)svm_trampoline_il_v_9,
+//   (void *)svm_trampoline_il_v_10,
+//   (void *)svm_trampoline_il_v_11,
+//   (void *)svm_trampoline_il_v_12,
+//   (void *)svm_trampoline_il_v_13,
+//   (void *)svm_trampoline_il_v_14,
+//   (void *)svm_trampoline_il_v_15,
+//   (void *)svm_trampoline_il_i_0,
+//   (void *)svm_trampoline_il_i_1,
+//   (void *)svm_trampoline_il_i_2,
+//   (void *)svm_trampoline_il_i_3,
+//   (void *)svm_trampoline_il_i_4,
+//   (void *)svm_trampoline_il_i_5,
+//   (void *)svm_trampoline_il_i_6,
+//   (void *)svm_trampoline_il_i_7,
+//   (void *)svm_trampoline_il_i_8,
+//   (void *)svm_trampoline_il_i_9,
+//   (void *)svm_trampoline_il_i_10,
+//   (void *)svm_trampoline_il_i_11,
+//   (void *)svm_trampoline_il_i_12,
+//   (void *)svm_trampoline_il_i_13,
+//   (void *)svm_trampoline_il_i_14,
+//   (void *)svm_trampoline_il_i_15,
+//   (void *)svm_trampoline_il_l_0,
+//   (void *)svm_trampoline_il_l_1,
+//   (void *)svm_trampoline_il_l_2,
+//   (void *)svm_trampoline_il_l_3,
+//   (void *)svm_trampoline_il_l_4,
+//   (void *)svm_trampoline_il_l_5,
+//   (void *)svm_trampoline_il_l_6,
+//   (void *)svm_trampoline_il_l_7,
+//   (void *)svm_trampoline_il_l_8,
+//   (void *)svm_trampoline_il_l_9,
+//   (void *)svm_trampoline_il_l_10,
+//   (void *)svm_trampoline_il_l_11,
+//   (void *)svm_trampoline_il_l_12,
+//   (void *)svm_trampoline_il_l_13,
+//   (void *)svm_trampoline_il_l_14,
+//   (void *)svm_trampoline_il_l_15,
+//   (void *)svm_trampoline_ili_v_0,
+//   (void *)svm_trampoline_ili_v_1,
+//   (void *)svm_trampoline_ili_v_2,
+//   (void *)svm_trampoline_ili_v_3,
+//   (void *)svm_trampoline_ili_v_4,
+//   (void *)svm_trampoline_ili_v_5,
+//   (void *)svm_trampoline_ili_v_6,
+//   (void *)svm_trampoline_ili_v_7,
+//   (void *)svm_trampoline_ili_v_8,
+//   (void *)svm_trampoline_ili_v_9,
+//   (void *)svm_trampoline_ili_v_10,
+//   (void *)svm_trampoline_ili_v_11,
+//   (void *)svm_trampoline_ili_v_12,
+//   (void *)svm_trampoline_ili_v_13,
+//   (void *)svm_trampoline_ili_v_14,
+//   (void *)svm_trampoline_ili_v_15,
+//   (void *)svm_trampoline_ili_i_0,
+//   (void *)svm_trampoline_ili_i_1,
+//   (void *)svm_trampoline_ili_i_2,
+//   (void *)svm_trampoline_ili_i_3,
+//   (void *)svm_trampoline_ili_i_4,
+//   (void *)svm_trampoline_ili_i_5,
+//   (void *)svm_trampoline_ili_i_6,
+//   (void *)svm_trampoline_ili_i_7,
+//   (void *)svm_trampoline_ili_i_8,
+//   (void *)svm_trampoline_ili_i_9,
+//   (void *)svm_trampoline_ili_i_10,
+//   (void *)svm_trampoline_ili_i_11,
+//   (void *)svm_trampoline_ili_i_12,
+//   (void *)svm_trampoline_ili_i_13,
+//   (void *)svm_trampoline_ili_i_14,
+//   (void *)svm_trampoline_ili_i_15,
+//   (void *)svm_trampoline_ili_l_0,
+//   (void *)svm_trampoline_ili_l_1,
+//   (void *)svm_trampoline_ili_l_2,
+//   (void *)svm_trampoline_ili_l_3,
+//   (void *)svm_trampoline_ili_l_4,
+//   (void *)svm_trampoline_ili_l_5,
+//   (void *)svm_trampoline_ili_l_6,
+//   (void *)svm_trampoline_ili_l_7,
+//   (void *)svm_trampoline_ili_l_8,
+//   (void *)svm_trampoline_ili_l_9,
+//   (void *)svm_trampoline_ili_l_10,
+//   (void *)svm_trampoline_ili_l_11,
+//   (void *)svm_trampoline_ili_l_12,
+//   (void *)svm_trampoline_ili_l_13,
+//   (void *)svm_trampoline_ili_l_14,
+//   (void *)svm_trampoline_ili_l_15,
+//   (void *)svm_trampoline_ilii_v_0,
+//   (void *)svm_trampoline_ilii_v_1,
+//   (void *)svm_trampoline_ilii_v_2,
+//   (void *)svm_trampoline_ilii_v_3,
+//   (void *)svm_trampoline_ilii_v_4,
+//   (void *)svm_trampoline_ilii_v_5,
+//   (void *)svm_trampoline_ilii_v_6,
+//   (void *)svm_trampoline_ilii_v_7,
+//   (void *)svm_trampoline_ilii_v_8,
+//   (void *)svm_trampoline_ilii_v_9,
+//   (void *)svm_trampoline_ilii_v_10,
+//   (void *)svm_trampoline_ilii_v_11,
+//   (void *)svm_trampoline_ilii_v_12,
+//   (void *)svm_trampoline_ilii_v_13,
+//   (void *)svm_trampoline_ilii_v_14,
+//   (void *)svm_trampoline_ilii_v_15,
+//   (void *)svm_trampoline_ilii_i_0,
+//   (void *)svm_trampoline_ilii_i_1,
+//   (void *)svm_trampoline_ilii_i_2,
+//   (void *)svm_trampoline_ilii_i_3,
+//   (void *)svm_trampoline_ilii_i_4,
+//   (void *)svm_trampoline_ilii_i_5,
+//   (void *)svm_trampoline_ilii_i_6,
+//   (void *)svm_trampoline_ilii_i_7,
+//   (void *)svm_trampoline_ilii_i_8,
+//   (void *)svm_trampoline_ilii_i_9,
+//   (void *)svm_trampoline_ilii_i_10,
+//   (void *)svm_trampoline_ilii_i_11,
+//   (void *)svm_trampoline_ilii_i_12,
+//   (void *)svm_trampoline_ilii_i_13,
+//   (void *)svm_trampoline_ilii_i_14,
+//   (void *)svm_trampoline_ilii_i_15,
+//   (void *)svm_trampoline_ilii_l_0,
+//   (void *)svm_trampoline_ilii_l_1,
+//   (void *)svm_trampoline_ilii_l_2,
+//   (void *)svm_trampoline_ilii_l_3,
+//   (void *)svm_trampoline_ilii_l_4,
+//   (void *)svm_trampoline_ilii_l_5,
+//   (void *)svm_trampoline_ilii_l_6,
+//   (void *)svm_trampoline_ilii_l_7,
+//   (void *)svm_trampoline_ilii_l_8,
+//   (void *)svm_trampoline_ilii_l_9,
+//   (void *)svm_trampoline_ilii_l_10,
+//   (void *)svm_trampoline_ilii_l_11,
+//   (void *)svm_trampoline_ilii_l_12,
+//   (void *)svm_trampoline_ilii_l_13,
+//   (void *)svm_trampoline_ilii_l_14,
+//   (void *)svm_trampoline_ilii_l_15,
+//   (void *)svm_trampoline_ilil_v_0,
+//   (void *)svm_trampoline_ilil_v_1,
+//   (void *)svm_trampoline_ilil_v_2,
+//   (void *)svm_trampoline_ilil_v_3,
+//   (void *)svm_trampoline_ilil_v_4,
+//   (void *)svm_trampoline_ilil_v_5,
+//   (void *)svm_trampoline_ilil_v_6,
+//   (void *)svm_trampoline_ilil_v_7,
+//   (void *)svm_trampoline_ilil_v_8,
+//   (void *)svm_trampoline_ilil_v_9,
+//   (void *)svm_trampoline_ilil_v_10,
+//   (void *)svm_trampoline_ilil_v_11,
+//   (void *)svm_trampoline_ilil_v_12,
+//   (void *)svm_trampoline_ilil_v_13,
+//   (void *)svm_trampoline_ilil_v_14,
+//   (void *)svm_trampoline_ilil_v_15,
+//   (void *)svm_trampoline_ilil_i_0,
+//   (void *)svm_trampoline_ilil_i_1,
+//   (void *)svm_trampoline_ilil_i_2,
+//   (void *)svm_trampoline_ilil_i_3,
+//   (void *)svm_trampoline_ilil_i_4,
+//   (void *)svm_trampoline_ilil_i_5,
+//   (void *)svm_trampoline_ilil_i_6,
+//   (void *)svm_trampoline_ilil_i_7,
+//   (void *)svm_trampoline_ilil_i_8,
+//   (void *)svm_trampoline_ilil_i_9,
+//   (void *)svm_trampoline_ilil_i_10,
+//   (void *)svm_trampoline_ilil_i_11,
+//   (void *)svm_trampoline_ilil_i_12,
+//   (void *)svm_trampoline_ilil_i_13,
+//   (void *)svm_trampoline_ilil_i_14,
+//   (void *)svm_trampoline_ilil_i_15,
+//   (void *)svm_trampoline_ilil_l_0,
+//   (void *)svm_trampoline_ilil_l_1,
+//   (void *)svm_trampoline_ilil_l_2,
+//   (void *)svm_trampoline_ilil_l_3,
+//   (void *)svm_trampoline_ilil_l_4,
+//   (void *)svm_trampoline_ilil_l_5,
+//   (void *)svm_trampoline_ilil_l_6,
+//   (void *)svm_trampoline_ilil_l_7,
+//   (void *)svm_trampoline_ilil_l_8,
+//   (void *)svm_trampoline_ilil_l_9,
+//   (void *)svm_trampoline_ilil_l_10,
+//   (void *)svm_trampoline_ilil_l_11,
+//   (void *)svm_trampoline_ilil_l_12,
+//   (void *)svm_trampoline_ilil_l_13,
+//   (void *)svm_trampoline_ilil_l_14,
+//   (void *)svm_trampoline_ilil_l_15,
+//   (void *)svm_trampoline_ill_v_0,
+//   (void *)svm_trampoline_ill_v_1,
+//   (void *)svm_trampoline_ill_v_2,
+//   (void *)svm_trampoline_ill_v_3,
+//   (void *)svm_trampoline_ill_v_4,
+//   (void *)svm_trampoline_ill_v_5,
+//   (void *)svm_trampoline_ill_v_6,
+//   (void *)svm_trampoline_ill_v_7,
+//   (void *)svm_trampoline_ill_v_8,
+//   (void *)svm_trampoline_ill_v_9,
+//   (void *)svm_trampoline_ill_v_10,
+//   (void *)svm_trampoline_ill_v_11,
+//   (void *)svm_trampoline_ill_v_12,
+//   (void *)svm_trampoline_ill_v_13,
+//   (void *)svm_trampoline_ill_v_14,
+//   (void *)svm_trampoline_ill_v_15,
+//   (void *)svm_trampoline_ill_i_0,
+//   (void *)svm_trampoline_ill_i_1,
+//   (void *)svm_trampoline_ill_i_2,
+//   (void *)svm_trampoline_ill_i_3,
+//   (void *)svm_trampoline_ill_i_4,
+//   (void *)svm_trampoline_ill_i_5,
+//   (void *)svm_trampoline_ill_i_6,
+//   (void *)svm_trampoline_ill_i_7,
+//   (void *)svm_trampoline_ill_i_8,
+//   (void *)svm_trampoline_ill_i_9,
+//   (void *)svm_trampoline_ill_i_10,
+//   (void *)svm_trampoline_ill_i_11,
+//   (void *)svm_trampoline_ill_i_12,
+//   (void *)svm_trampoline_ill_i_13,
+//   (void *)svm_trampoline_ill_i_14,
+//   (void *)svm_trampoline_ill_i_15,
+//   (void *)svm_trampoline_ill_l_0,
+//   (void *)svm_trampoline_ill_l_1,
+//   (void *)svm_trampoline_ill_l_2,
+//   (void *)svm_trampoline_ill_l_3,
+//   (void *)svm_trampoline_ill_l_4,
+//   (void *)svm_trampoline_ill_l_5,
+//   (void *)svm_trampoline_ill_l_6,
+//   (void *)svm_trampoline_ill_l_7,
+//   (void *)svm_trampoline_ill_l_8,
+//   (void *)svm_trampoline_ill_l_9,
+//   (void *)svm_trampoline_ill_l_10,
+//   (void *)svm_trampoline_ill_l_11,
+//   (void *)svm_trampoline_ill_l_12,
+//   (void *)svm_trampoline_ill_l_13,
+//   (void *)svm_trampoline_ill_l_14,
+//   (void *)svm_trampoline_ill_l_15,
+//   (void *)svm_trampoline_illi_v_0,
+//   (void *)svm_trampoline_illi_v_1,
+//   (void *)svm_trampoline_illi_v_2,
+//   (void *)svm_trampoline_illi_v_3,
+//   (void *)svm_trampoline_illi_v_4,
+//   (void *)svm_trampoline_illi_v_5,
+//   (void *)svm_trampoline_illi_v_6,
+//   (void *)svm_trampoline_illi_v_7,
+//   (void *)svm_trampoline_illi_v_8,
+//   (void *)svm_trampoline_illi_v_9,
+//   (void *)svm_trampoline_illi_v_10,
+//   (void *)svm_trampoline_illi_v_11,
+//   (void *)svm_trampoline_illi_v_12,
+//   (void *)svm_trampoline_illi_v_13,
+//   (void *)svm_trampoline_illi_v_14,
+//   (void *)svm_trampoline_illi_v_15,
+//   (void *)svm_trampoline_illi_i_0,
+//   (void *)svm_trampoline_illi_i_1,
+//   (void *)svm_trampoline_illi_i_2,
+//   (void *)svm_trampoline_illi_i_3,
+//   (void *)svm_trampoline_illi_i_4,
+//   (void *)svm_trampoline_illi_i_5,
+//   (void *)svm_trampoline_illi_i_6,
+//   (void *)svm_trampoline_illi_i_7,
+//   (void *)svm_trampoline_illi_i_8,
+//   (void *)svm_trampoline_illi_i_9,
+//   (void *)svm_trampoline_illi_i_10,
+//   (void *)svm_trampoline_illi_i_11,
+//   (void *)svm_trampoline_illi_i_12,
+//   (void *)svm_trampoline_illi_i_13,
+//   (void *)svm_trampoline_illi_i_14,
+//   (void *)svm_trampoline_illi_i_15,
+//   (void *)svm_trampoline_illi_l_0,
+//   (void *)svm_trampoline_illi_l_1,
+//   (void *)svm_trampoline_illi_l_2,
+//   (void *)svm_trampoline_illi_l_3,
+//   (void *)svm_trampoline_illi_l_4,
+//   (void *)svm_trampoline_illi_l_5,
+//   (void *)svm_trampoline_illi_l_6,
+//   (void *)svm_trampoline_illi_l_7,
+//   (void *)svm_trampoline_illi_l_8,
+//   (void *)svm_trampoline_illi_l_9,
+//   (void *)svm_trampoline_illi_l_10,
+//   (void *)svm_trampoline_illi_l_11,
+//   (void *)svm_trampoline_illi_l_12,
+//   (void *)svm_trampoline_illi_l_13,
+//   (void *)svm_trampoline_illi_l_14,
+//   (void *)svm_trampoline_illi_l_15,
+//   (void *)svm_trampoline_illl_v_0,
+//   (void *)svm_trampoline_illl_v_1,
+//   (void *)svm_trampoline_illl_v_2,
+//   (void *)svm_trampoline_illl_v_3,
+//   (void *)svm_trampoline_illl_v_4,
+//   (void *)svm_trampoline_illl_v_5,
+//   (void *)svm_trampoline_illl_v_6,
+//   (void *)svm_trampoline_illl_v_7,
+//   (void *)svm_trampoline_illl_v_8,
+//   (void *)svm_trampoline_illl_v_9,
+//   (void *)svm_trampoline_illl_v_10,
+//   (void *)svm_trampoline_illl_v_11,
+//   (void *)svm_trampoline_illl_v_12,
+//   (void *)svm_trampoline_illl_v_13,
+//   (void *)svm_trampoline_illl_v_14,
+//   (void *)svm_trampoline_illl_v_15,
+//   (void *)svm_trampoline_illl_i_0,
+//   (void *)svm_trampoline_illl_i_1,
+//   (void *)svm_trampoline_illl_i_2,
+//   (void *)svm_trampoline_illl_i_3,
+//   (void *)svm_trampoline_illl_i_4,
+//   (void *)svm_trampoline_illl_i_5,
+//   (void *)svm_trampoline_illl_i_6,
+//   (void *)svm_trampoline_illl_i_7,
+//   (void *)svm_trampoline_illl_i_8,
+//   (void *)svm_trampoline_illl_i_9,
+//   (void *)svm_trampoline_illl_i_10,
+//   (void *)svm_trampoline_illl_i_11,
+//   (void *)svm_trampoline_illl_i_12,
+//   (void *)svm_trampoline_illl_i_13,
+//   (void *)svm_trampoline_illl_i_14,
+//   (void *)svm_trampoline_illl_i_15,
+//   (void *)svm_trampoline_illl_l_0,
+//   (void *)svm_trampoline_illl_l_1,
+//   (void *)svm_trampoline_illl_l_2,
+//   (void *)svm_trampoline_illl_l_3,
+//   (void *)svm_trampoline_illl_l_4,
+//   (void *)svm_trampoline_illl_l_5,
+//   (void *)svm_trampoline_illl_l_6,
+//   (void *)svm_trampoline_illl_l_7,
+//   (void *)svm_trampoline_illl_l_8,
+//   (void *)svm_trampoline_illl_l_9,
+//   (void *)svm_trampoline_illl_l_10,
+//   (void *)svm_trampoline_illl_l_11,
+//   (void *)svm_trampoline_illl_l_12,
+//   (void *)svm_trampoline_illl_l_13,
+//   (void *)svm_trampoline_illl_l_14,
+//   (void *)svm_trampoline_illl_l_15,
+//   (void *)svm_trampoline_l_v_0,
+//   (void *)svm_trampoline_l_v_1,
+//   (void *)svm_trampoline_l_v_2,
+//   (void *)svm_trampoline_l_v_3,
+//   (void *)svm_trampoline_l_v_4,
+//   (void *)svm_trampoline_l_v_5,
+//   (void *)svm_trampoline_l_v_6,
+//   (void *)svm_trampoline_l_v_7,
+//   (void *)svm_trampoline_l_v_8,
+//   (void *)svm_trampoline_l_v_9,
+//   (void *)svm_trampoline_l_v_10,
+//   (void *)svm_trampoline_l_v_11,
+//   (void *)svm_trampoline_l_v_12,
+//   (void *)svm_trampoline_l_v_13,
+//   (void *)svm_trampoline_l_v_14,
+//   (void *)svm_trampoline_l_v_15,
+//   (void *)svm_trampoline_l_i_0,
+//   (void *)svm_trampoline_l_i_1,
+//   (void *)svm_trampoline_l_i_2,
+//   (void *)svm_trampoline_l_i_3,
+//   (void *)svm_trampoline_l_i_4,
+//   (void *)svm_trampoline_l_i_5,
+//   (void *)svm_trampoline_l_i_6,
+//   (void *)svm_trampoline_l_i_7,
+//   (void *)svm_trampoline_l_i_8,
+//   (void *)svm_trampoline_l_i_9,
+//   (void *)svm_trampoline_l_i_10,
+//   (void *)svm_trampoline_l_i_11,
+//   (void *)svm_trampoline_l_i_12,
+//   (void *)svm_trampoline_l_i_13,
+//   (void *)svm_trampoline_l_i_14,
+//   (void *)svm_trampoline_l_i_15,
+//   (void *)svm_trampoline_l_l_0,
+//   (void *)svm_trampoline_l_l_1,
+//   (void *)svm_trampoline_l_l_2,
+//   (void *)svm_trampoline_l_l_3,
+//   (void *)svm_trampoline_l_l_4,
+//   (void *)svm_trampoline_l_l_5,
+//   (void *)svm_trampoline_l_l_6,
+//   (void *)svm_trampoline_l_l_7,
+//   (void *)svm_trampoline_l_l_8,
+//   (void *)svm_trampoline_l_l_9,
+//   (void *)svm_trampoline_l_l_10,
+//   (void *)svm_trampoline_l_l_11,
+//   (void *)svm_trampoline_l_l_12,
+//   (void *)svm_trampoline_l_l_13,
+//   (void *)svm_trampoline_l_l_14,
+//   (void *)svm_trampoline_l_l_15,
+//   (void *)svm_trampoline_li_v_0,
+//   (void *)svm_trampoline_li_v_1,
+//   (void *)svm_trampoline_li_v_2,
+//   (void *)svm_trampoline_li_v_3,
+//   (void *)svm_trampoline_li_v_4,
+//   (void *)svm_trampoline_li_v_5,
+//   (void *)svm_trampoline_li_v_6,
+//   (void *)svm_trampoline_li_v_7,
+//   (void *)svm_trampoline_li_v_8,
+//   (void *)svm_trampoline_li_v_9,
+//   (void *)svm_trampoline_li_v_10,
+//   (void *)svm_trampoline_li_v_11,
+//   (void *)svm_trampoline_li_v_12,
+//   (void *)svm_trampoline_li_v_13,
+//   (void *)svm_trampoline_li_v_14,
+//   (void *)svm_trampoline_li_v_15,
+//   (void *)svm_trampoline_li_i_0,
+//   (void *)svm_trampoline_li_i_1,
+//   (void *)svm_trampoline_li_i_2,
+//   (void *)svm_trampoline_li_i_3,
+//   (void *)svm_trampoline_li_i_4,
+//   (void *)svm_trampoline_li_i_5,
+//   (void *)svm_trampoline_li_i_6,
+//   (void *)svm_trampoline_li_i_7,
+//   (void *)svm_trampoline_li_i_8,
+//   (void *)svm_trampoline_li_i_9,
+//   (void *)svm_trampoline_li_i_10,
+//   (void *)svm_trampoline_li_i_11,
+//   (void *)svm_trampoline_li_i_12,
+//   (void *)svm_trampoline_li_i_13,
+//   (void *)svm_trampoline_li_i_14,
+//   (void *)svm_trampoline_li_i_15,
+//   (void *)svm_trampoline_li_l_0,
+//   (void *)svm_trampoline_li_l_1,
+//   (void *)svm_trampoline_li_l_2,
+//   (void *)svm_trampoline_li_l_3,
+//   (void *)svm_trampoline_li_l_4,
+//   (void *)svm_trampoline_li_l_5,
+//   (void *)svm_trampoline_li_l_6,
+//   (void *)svm_trampoline_li_l_7,
+//   (void *)svm_trampoline_li_l_8,
+//   (void *)svm_trampoline_li_l_9,
+//   (void *)svm_trampoline_li_l_10,
+//   (void *)svm_trampoline_li_l_11,
+//   (void *)svm_trampoline_li_l_12,
+//   (void *)svm_trampoline_li_l_13,
+//   (void *)svm_trampoline_li_l_14,
+//   (void *)svm_trampoline_li_l_15,
+//   (void *)svm_trampoline_lii_v_0,
+//   (void *)svm_trampoline_lii_v_1,
+//   (void *)svm_trampoline_lii_v_2,
+//   (void *)svm_trampoline_lii_v_3,
+//   (void *)svm_trampoline_lii_v_4,
+//   (void *)svm_trampoline_lii_v_5,
+//   (void *)svm_trampoline_lii_v_6,
+//   (void *)svm_trampoline_lii_v_7,
+//   (void *)svm_trampoline_lii_v_8,
+//   (void *)svm_trampoline_lii_v_9,
+//   (void *)svm_trampoline_lii_v_10,
+//   (void *)svm_trampoline_lii_v_11,
+//   (void *)svm_trampoline_lii_v_12,
+//   (void *)svm_trampoline_lii_v_13,
+//   (void *)svm_trampoline_lii_v_14,
+//   (void *)svm_trampoline_lii_v_15,
+//   (void *)svm_trampoline_lii_i_0,
+//   (void *)svm_trampoline_lii_i_1,
+//   (void *)svm_trampoline_lii_i_2,
+//   (void *)svm_trampoline_lii_i_3,
+//   (void *)svm_trampoline_lii_i_4,
+//   (void *)svm_trampoline_lii_i_5,
+//   (void *)svm_trampoline_lii_i_6,
+//   (void *)svm_trampoline_lii_i_7,
+//   (void *)svm_trampoline_lii_i_8,
+//   (void *)svm_trampoline_lii_i_9,
+//   (void *)svm_trampoline_lii_i_10,
+//   (void *)svm_trampoline_lii_i_11,
+//   (void *)svm_trampoline_lii_i_12,
+//   (void *)svm_trampoline_lii_i_13,
+//   (void *)svm_trampoline_lii_i_14,
+//   (void *)svm_trampoline_lii_i_15,
+//   (void *)svm_trampoline_lii_l_0,
+//   (void *)svm_trampoline_lii_l_1,
+//   (void *)svm_trampoline_lii_l_2,
+//   (void *)svm_trampoline_lii_l_3,
+//   (void *)svm_trampoline_lii_l_4,
+//   (void *)svm_trampoline_lii_l_5,
+//   (void *)svm_trampoline_lii_l_6,
+//   (void *)svm_trampoline_lii_l_7,
+//   (void *)svm_trampoline_lii_l_8,
+//   (void *)svm_trampoline_lii_l_9,
+//   (void *)svm_trampoline_lii_l_10,
+//   (void *)svm_trampoline_lii_l_11,
+//   (void *)svm_trampoline_lii_l_12,
+//   (void *)svm_trampoline_lii_l_13,
+//   (void *)svm_trampoline_lii_l_14,
+//   (void *)svm_trampoline_lii_l_15,
+//   (void *)svm_trampoline_liii_v_0,
+//   (void *)svm_trampoline_liii_v_1,
+//   (void *)svm_trampoline_liii_v_2,
+//   (void *)svm_trampoline_liii_v_3,
+//   (void *)svm_trampoline_liii_v_4,
+//   (void *)svm_trampoline_liii_v_5,
+//   (void *)svm_trampoline_liii_v_6,
+//   (void *)svm_trampoline_liii_v_7,
+//   (void *)svm_trampoline_liii_v_8,
+//   (void *)svm_trampoline_liii_v_9,
+//   (void *)svm_trampoline_liii_v_10,
+//   (void *)svm_trampoline_liii_v_11,
+//   (void *)svm_trampoline_liii_v_12,
+//   (void *)svm_trampoline_liii_v_13,
+//   (void *)svm_trampoline_liii_v_14,
+//   (void *)svm_trampoline_liii_v_15,
+//   (void *)svm_trampoline_liii_i_0,
+//   (void *)svm_trampoline_liii_i_1,
+//   (void *)svm_trampoline_liii_i_2,
+//   (void *)svm_trampoline_liii_i_3,
+//   (void *)svm_trampoline_liii_i_4,
+//   (void *)svm_trampoline_liii_i_5,
+//   (void *)svm_trampoline_liii_i_6,
+//   (void *)svm_trampoline_liii_i_7,
+//   (void *)svm_trampoline_liii_i_8,
+//   (void *)svm_trampoline_liii_i_9,
+//   (void *)svm_trampoline_liii_i_10,
+//   (void *)svm_trampoline_liii_i_11,
+//   (void *)svm_trampoline_liii_i_12,
+//   (void *)svm_trampoline_liii_i_13,
+//   (void *)svm_trampoline_liii_i_14,
+//   (void *)svm_trampoline_liii_i_15,
+//   (void *)svm_trampoline_liii_l_0,
+//   (void *)svm_trampoline_liii_l_1,
+//   (void *)svm_trampoline_liii_l_2,
+//   (void *)svm_trampoline_liii_l_3,
+//   (void *)svm_trampoline_liii_l_4,
+//   (void *)svm_trampoline_liii_l_5,
+//   (void *)svm_trampoline_liii_l_6,
+//   (void *)svm_trampoline_liii_l_7,
+//   (void *)svm_trampoline_liii_l_8,
+//   (void *)svm_trampoline_liii_l_9,
+//   (void *)svm_trampoline_liii_l_10,
+//   (void *)svm_trampoline_liii_l_11,
+//   (void *)svm_trampoline_liii_l_12,
+//   (void *)svm_trampoline_liii_l_13,
+//   (void *)svm_trampoline_liii_l_14,
+//   (void *)svm_trampoline_liii_l_15,
+//   (void *)svm_trampoline_liil_v_0,
+//   (void *)svm_trampoline_liil_v_1,
+//   (void *)svm_trampoline_liil_v_2,
+//   (void *)svm_trampoline_liil_v_3,
+//   (void *)svm_trampoline_liil_v_4,
+//   (void *)svm_trampoline_liil_v_5,
+//   (void *)svm_trampoline_liil_v_6,
+//   (void *)svm_trampoline_liil_v_7,
+//   (void *)svm_trampoline_liil_v_8,
+//   (void *)svm_trampoline_liil_v_9,
+//   (void *)svm_trampoline_liil_v_10,
+//   (void *)svm_trampoline_liil_v_11,
+//   (void *)svm_trampoline_liil_v_12,
+//   (void *)svm_trampoline_liil_v_13,
+//   (void *)svm_trampoline_liil_v_14,
+//   (void *)svm_trampoline_liil_v_15,
+//   (void *)svm_trampoline_liil_i_0,
+//   (void *)svm_trampoline_liil_i_1,
+//   (void *)svm_trampoline_liil_i_2,
+//   (void *)svm_trampoline_liil_i_3,
+//   (void *)svm_trampoline_liil_i_4,
+//   (void *)svm_trampoline_liil_i_5,
+//   (void *)svm_trampoline_liil_i_6,
+//   (void *)svm_trampoline_liil_i_7,
+//   (void *)svm_trampoline_liil_i_8,
+//   (void *)svm_trampoline_liil_i_9,
+//   (void *)svm_trampoline_liil_i_10,
+//   (void *)svm_trampoline_liil_i_11,
+//   (void *)svm_trampoline_liil_i_12,
+//   (void *)svm_trampoline_liil_i_13,
+//   (void *)svm_trampoline_liil_i_14,
+//   (void *)svm_trampoline_liil_i_15,
+//   (void *)svm_trampoline_liil_l_0,
+//   (void *)svm_trampoline_liil_l_1,
+//   (void *)svm_trampoline_liil_l_2,
+//   (void *)svm_trampoline_liil_l_3,
+//   (void *)svm_trampoline_liil_l_4,
+//   (void *)svm_trampoline_liil_l_5,
+//   (void *)svm_trampoline_liil_l_6,
+//   (void *)svm_trampoline_liil_l_7,
+//   (void *)svm_trampoline_liil_l_8,
+//   (void *)svm_trampoline_liil_l_9,
+//   (void *)svm_trampoline_liil_l_10,
+//   (void *)svm_trampoline_liil_l_11,
+//   (void *)svm_trampoline_liil_l_12,
+//   (void *)svm_trampoline_liil_l_13,
+//   (void *)svm_trampoline_liil_l_14,
+//   (void *)svm_trampoline_liil_l_15,
+//   (void *)svm_trampoline_lil_v_0,
+//   (void *)svm_trampoline_lil_v_1,
+//   (void *)svm_trampoline_lil_v_2,
+//   (void *)svm_trampoline_lil_v_3,
+//   (void *)svm_trampoline_lil_v_4,
+//   (void *)svm_trampoline_lil_v_5,
+//   (void *)svm_trampoline_lil_v_6,
+//   (void *)svm_trampoline_lil_v_7,
+//   (void *)svm_trampoline_lil_v_8,
+//   (void *)svm_trampoline_lil_v_9,
+//   (void *)svm_trampoline_lil_v_10,
+//   (void *)svm_trampoline_lil_v_11,
+//   (void *)svm_trampoline_lil_v_12,
+//   (void *)svm_trampoline_lil_v_13,
+//   (void *)svm_trampoline_lil_v_14,
+//   (void *)svm_trampoline_lil_v_15,
+//   (void *)svm_trampoline_lil_i_0,
+//   (void *)svm_trampoline_lil_i_1,
+//   (void *)svm_trampoline_lil_i_2,
+//   (void *)svm_trampoline_lil_i_3,
+//   (void *)svm_trampoline_lil_i_4,
+//   (void *)svm_trampoline_lil_i_5,
+//   (void *)svm_trampoline_lil_i_6,
+//   (void *)svm_trampoline_lil_i_7,
+//   (void *)svm_trampoline_lil_i_8,
+//   (void *)svm_trampoline_lil_i_9,
+//   (void *)svm_trampoline_lil_i_10,
+//   (void *)svm_trampoline_lil_i_11,
+//   (void *)svm_trampoline_lil_i_12,
+//   (void *)svm_trampoline_lil_i_13,
+//   (void *)svm_trampoline_lil_i_14,
+//   (void *)svm_trampoline_lil_i_15,
+//   (void *)svm_trampoline_lil_l_0,
+//   (void *)svm_trampoline_lil_l_1,
+//   (void *)svm_trampoline_lil_l_2,
+//   (void *)svm_trampoline_lil_l_3,
+//   (void *)svm_trampoline_lil_l_4,
+//   (void *)svm_trampoline_lil_l_5,
+//   (void *)svm_trampoline_lil_l_6,
+//   (void *)svm_trampoline_lil_l_7,
+//   (void *)svm_trampoline_lil_l_8,
+//   (void *)svm_trampoline_lil_l_9,
+//   (void *)svm_trampoline_lil_l_10,
+//   (void *)svm_trampoline_lil_l_11,
+//   (void *)svm_trampoline_lil_l_12,
+//   (void *)svm_trampoline_lil_l_13,
+//   (void *)svm_trampoline_lil_l_14,
+//   (void *)svm_trampoline_lil_l_15,
+//   (void *)svm_trampoline_lili_v_0,
+//   (void *)svm_trampoline_lili_v_1,
+//   (void *)svm_trampoline_lili_v_2,
+//   (void *)svm_trampoline_lili_v_3,
+//   (void *)svm_trampoline_lili_v_4,
+//   (void *)svm_trampoline_lili_v_5,
+//   (void *)svm_trampoline_lili_v_6,
+//   (void *)svm_trampoline_lili_v_7,
+//   (void *)svm_trampoline_lili_v_8,
+//   (void *)svm_trampoline_lili_v_9,
+//   (void *)svm_trampoline_lili_v_10,
+//   (void *)svm_trampoline_lili_v_11,
+//   (void *)svm_trampoline_lili_v_12,
+//   (void *)svm_trampoline_lili_v_13,
+//   (void *)svm_trampoline_lili_v_14,
+//   (void *)svm_trampoline_lili_v_15,
+//   (void *)svm_trampoline_lili_i_0,
+//   (void *)svm_trampoline_lili_i_1,
+//   (void *)svm_trampoline_lili_i_2,
+//   (void *)svm_trampoline_lili_i_3,
+//   (void *)svm_trampoline_lili_i_4,
+//   (void *)svm_trampoline_lili_i_5,
+//   (void *)svm_trampoline_lili_i_6,
+//   (void *)svm_trampoline_lili_i_7,
+//   (void *)svm_trampoline_lili_i_8,
+//   (void *)svm_trampoline_lili_i_9,
+//   (void *)svm_trampoline_lili_i_10,
+//   (void *)svm_trampoline_lili_i_11,
+//   (void *)svm_trampoline_lili_i_12,
+//   (void *)svm_trampoline_lili_i_13,
+//   (void *)svm_trampoline_lili_i_14,
+//   (void *)svm_trampoline_lili_i_15,
+//   (void *)svm_trampoline_lili_l_0,
+//   (void *)svm_trampoline_lili_l_1,
+//   (void *)svm_trampoline_lili_l_2,
+//   (void *)svm_trampoline_lili_l_3,
+//   (void *)svm_trampoline_lili_l_4,
+//   (void *)svm_trampoline_lili_l_5,
+//   (void *)svm_trampoline_lili_l_6,
+//   (void *)svm_trampoline_lili_l_7,
+//   (void *)svm_trampoline_lili_l_8,
+//   (void *)svm_trampoline_lili_l_9,
+//   (void *)svm_trampoline_lili_l_10,
+//   (void *)svm_trampoline_lili_l_11,
+//   (void *)svm_trampoline_lili_l_12,
+//   (void *)svm_trampoline_lili_l_13,
+//   (void *)svm_trampoline_lili_l_14,
+//   (void *)svm_trampoline_lili_l_15,
+//   (void *)svm_trampoline_lill_v_0,
+//   (void *)svm_trampoline_lill_v_1,
+//   (void *)svm_trampoline_lill_v_2,
+//   (void *)svm_trampoline_lill_v_3,
+//   (void *)svm_trampoline_lill_v_4,
+//   (void *)svm_trampoline_lill_v_5,
+//   (void *)svm_trampoline_lill_v_6,
+//   (void *)svm_trampoline_lill_v_7,
+//   (void *)svm_trampoline_lill_v_8,
+//   (void *)svm_trampoline_lill_v_9,
+//   (void *)svm_trampoline_lill_v_10,
+//   (void *)svm_trampoline_lill_v_11,
+//   (void *)svm_trampoline_lill_v_12,
+//   (void *)svm_trampoline_lill_v_13,
+//   (void *)svm_trampoline_lill_v_14,
+//   (void *)svm_trampoline_lill_v_15,
+//   (void *)svm_trampoline_lill_i_0,
+//   (void *)svm_trampoline_lill_i_1,
+//   (void *)svm_trampoline_lill_i_2,
+//   (void *)svm_trampoline_lill_i_3,
+//   (void *)svm_trampoline_lill_i_4,
+//   (void *)svm_trampoline_lill_i_5,
+//   (void *)svm_trampoline_lill_i_6,
+//   (void *)svm_trampoline_lill_i_7,
+//   (void *)svm_trampoline_lill_i_8,
+//   (void *)svm_trampoline_lill_i_9,
+//   (void *)svm_trampoline_lill_i_10,
+//   (void *)svm_trampoline_lill_i_11,
+//   (void *)svm_trampoline_lill_i_12,
+//   (void *)svm_trampoline_lill_i_13,
+//   (void *)svm_trampoline_lill_i_14,
+//   (void *)svm_trampoline_lill_i_15,
+//   (void *)svm_trampoline_lill_l_0,
+//   (void *)svm_trampoline_lill_l_1,
+//   (void *)svm_trampoline_lill_l_2,
+//   (void *)svm_trampoline_lill_l_3,
+//   (void *)svm_trampoline_lill_l_4,
+//   (void *)svm_trampoline_lill_l_5,
+//   (void *)svm_trampoline_lill_l_6,
+//   (void *)svm_trampoline_lill_l_7,
+//   (void *)svm_trampoline_lill_l_8,
+//   (void *)svm_trampoline_lill_l_9,
+//   (void *)svm_trampoline_lill_l_10,
+//   (void *)svm_trampoline_lill_l_11,
+//   (void *)svm_trampoline_lill_l_12,
+//   (void *)svm_trampoline_lill_l_13,
+//   (void *)svm_trampoline_lill_l_14,
+//   (void *)svm_trampoline_lill_l_15,
+//   (void *)svm_trampoline_ll_v_0,
+//   (void *)svm_trampoline_ll_v_1,
+//   (void *)svm_trampoline_ll_v_2,
+//   (void *)svm_trampoline_ll_v_3,
+//   (void *)svm_trampoline_ll_v_4,
+//   (void *)svm_trampoline_ll_v_5,
+//   (void *)svm_trampoline_ll_v_6,
+//   (void *)svm_trampoline_ll_v_7,
+//   (void *)svm_trampoline_ll_v_8,
+//   (void *)svm_trampoline_ll_v_9,
+//   (void *)svm_trampoline_ll_v_10,
+//   (void *)svm_trampoline_ll_v_11,
+//   (void *)svm_trampoline_ll_v_12,
+//   (void *)svm_trampoline_ll_v_13,
+//   (void *)svm_trampoline_ll_v_14,
+//   (void *)svm_trampoline_ll_v_15,
+//   (void *)svm_trampoline_ll_i_0,
+//   (void *)svm_trampoline_ll_i_1,
+//   (void *)svm_trampoline_ll_i_2,
+//   (void *)svm_trampoline_ll_i_3,
+//   (void *)svm_trampoline_ll_i_4,
+//   (void *)svm_trampoline_ll_i_5,
+//   (void *)svm_trampoline_ll_i_6,
+//   (void *)svm_trampoline_ll_i_7,
+//   (void *)svm_trampoline_ll_i_8,
+//   (void *)svm_trampoline_ll_i_9,
+//   (void *)svm_trampoline_ll_i_10,
+//   (void *)svm_trampoline_ll_i_11,
+//   (void *)svm_trampoline_ll_i_12,
+//   (void *)svm_trampoline_ll_i_13,
+//   (void *)svm_trampoline_ll_i_14,
+//   (void *)svm_trampoline_ll_i_15,
+//   (void *)svm_trampoline_ll_l_0,
+//   (void *)svm_trampoline_ll_l_1,
+//   (void *)svm_trampoline_ll_l_2,
+//   (void *)svm_trampoline_ll_l_3,
+//   (void *)svm_trampoline_ll_l_4,
+//   (void *)svm_trampoline_ll_l_5,
+//   (void *)svm_trampoline_ll_l_6,
+//   (void *)svm_trampoline_ll_l_7,
+//   (void *)svm_trampoline_ll_l_8,
+//   (void *)svm_trampoline_ll_l_9,
+//   (void *)svm_trampoline_ll_l_10,
+//   (void *)svm_trampoline_ll_l_11,
+//   (void *)svm_trampoline_ll_l_12,
+//   (void *)svm_trampoline_ll_l_13,
+//   (void *)svm_trampoline_ll_l_14,
+//   (void *)svm_trampoline_ll_l_15,
+//   (void *)svm_trampoline_lli_v_0,
+//   (void *)svm_trampoline_lli_v_1,
+//   (void *)svm_trampoline_lli_v_2,
+//   (void *)svm_trampoline_lli_v_3,
+//   (void *)svm_trampoline_lli_v_4,
+//   (void *)svm_trampoline_lli_v_5,
+//   (void *)svm_trampoline_lli_v_6,
+//   (void *)svm_trampoline_lli_v_7,
+//   (void *)svm_trampoline_lli_v_8,
+//   (void *)svm_trampoline_lli_v_9,
+//   (void *)svm_trampoline_lli_v_10,
+//   (void *)svm_trampoline_lli_v_11,
+//   (void *)svm_trampoline_lli_v_12,
+//   (void *)svm_trampoline_lli_v_13,
+//   (void *)svm_trampoline_lli_v_14,
+//   (void *)svm_trampoline_lli_v_15,
+//   (void *)svm_trampoline_lli_i_0,
+//   (void *)svm_trampoline_lli_i_1,
+//   (void *)svm_trampoline_lli_i_2,
+//   (void *)svm_trampoline_lli_i_3,
+//   (void *)svm_trampoline_lli_i_4,
+//   (void *)svm_trampoline_lli_i_5,
+//   (void *)svm_trampoline_lli_i_6,
+//   (void *)svm_trampoline_lli_i_7,
+//   (void *)svm_trampoline_lli_i_8,
+//   (void *)svm_trampoline_lli_i_9,
+//   (void *)svm_trampoline_lli_i_10,
+//   (void *)svm_trampoline_lli_i_11,
+//   (void *)svm_trampoline_lli_i_12,
+//   (void *)svm_trampoline_lli_i_13,
+//   (void *)svm_trampoline_lli_i_14,
+//   (void *)svm_trampoline_lli_i_15,
+//   (void *)svm_trampoline_lli_l_0,
+//   (void *)svm_trampoline_lli_l_1,
+//   (void *)svm_trampoline_lli_l_2,
+//   (void *)svm_trampoline_lli_l_3,
+//   (void *)svm_trampoline_lli_l_4,
+//   (void *)svm_trampoline_lli_l_5,
+//   (void *)svm_trampoline_lli_l_6,
+//   (void *)svm_trampoline_lli_l_7,
+//   (void *)svm_trampoline_lli_l_8,
+//   (void *)svm_trampoline_lli_l_9,
+//   (void *)svm_trampoline_lli_l_10,
+//   (void *)svm_trampoline_lli_l_11,
+//   (void *)svm_trampoline_lli_l_12,
+//   (void *)svm_trampoline_lli_l_13,
+//   (void *)svm_trampoline_lli_l_14,
+//   (void *)svm_trampoline_lli_l_15,
+//   (void *)svm_trampoline_llii_v_0,
+//   (void *)svm_trampoline_llii_v_1,
+//   (void *)svm_trampoline_llii_v_2,
+//   (void *)svm_trampoline_llii_v_3,
+//   (void *)svm_trampoline_llii_v_4,
+//   (void *)svm_trampoline_llii_v_5,
+//   (void *)svm_trampoline_llii_v_6,
+//   (void *)svm_trampoline_llii_v_7,
+//   (void *)svm_trampoline_llii_v_8,
+//   (void *)svm_trampoline_llii_v_9,
+//   (void *)svm_trampoline_llii_v_10,
+//   (void *)svm_trampoline_llii_v_11,
+//   (void *)svm_trampoline_llii_v_12,
+//   (void *)svm_trampoline_llii_v_13,
+//   (void *)svm_trampoline_llii_v_14,
+//   (void *)svm_trampoline_llii_v_15,
+//   (void *)svm_trampoline_llii_i_0,
+//   (void *)svm_trampoline_llii_i_1,
+//   (void *)svm_trampoline_llii_i_2,
+//   (void *)svm_trampoline_llii_i_3,
+//   (void *)svm_trampoline_llii_i_4,
+//   (void *)svm_trampoline_llii_i_5,
+//   (void *)svm_trampoline_llii_i_6,
+//   (void *)svm_trampoline_llii_i_7,
+//   (void *)svm_trampoline_llii_i_8,
+//   (void *)svm_trampoline_llii_i_9,
+//   (void *)svm_trampoline_llii_i_10,
+//   (void *)svm_trampoline_llii_i_11,
+//   (void *)svm_trampoline_llii_i_12,
+//   (void *)svm_trampoline_llii_i_13,
+//   (void *)svm_trampoline_llii_i_14,
+//   (void *)svm_trampoline_llii_i_15,
+//   (void *)svm_trampoline_llii_l_0,
+//   (void *)svm_trampoline_llii_l_1,
+//   (void *)svm_trampoline_llii_l_2,
+//   (void *)svm_trampoline_llii_l_3,
+//   (void *)svm_trampoline_llii_l_4,
+//   (void *)svm_trampoline_llii_l_5,
+//   (void *)svm_trampoline_llii_l_6,
+//   (void *)svm_trampoline_llii_l_7,
+//   (void *)svm_trampoline_llii_l_8,
+//   (void *)svm_trampoline_llii_l_9,
+//   (void *)svm_trampoline_llii_l_10,
+//   (void *)svm_trampoline_llii_l_11,
+//   (void *)svm_trampoline_llii_l_12,
+//   (void *)svm_trampoline_llii_l_13,
+//   (void *)svm_trampoline_llii_l_14,
+//   (void *)svm_trampoline_llii_l_15,
+//   (void *)svm_trampoline_llil_v_0,
+//   (void *)svm_trampoline_llil_v_1,
+//   (void *)svm_trampoline_llil_v_2,
+//   (void *)svm_trampoline_llil_v_3,
+//   (void *)svm_trampoline_llil_v_4,
+//   (void *)svm_trampoline_llil_v_5,
+//   (void *)svm_trampoline_llil_v_6,
+//   (void *)svm_trampoline_llil_v_7,
+//   (void *)svm_trampoline_llil_v_8,
+//   (void *)svm_trampoline_llil_v_9,
+//   (void *)svm_trampoline_llil_v_10,
+//   (void *)svm_trampoline_llil_v_11,
+//   (void *)svm_trampoline_llil_v_12,
+//   (void *)svm_trampoline_llil_v_13,
+//   (void *)svm_trampoline_llil_v_14,
+//   (void *)svm_trampoline_llil_v_15,
+//   (void *)svm_trampoline_llil_i_0,
+//   (void *)svm_trampoline_llil_i_1,
+//   (void *)svm_trampoline_llil_i_2,
+//   (void *)svm_trampoline_llil_i_3,
+//   (void *)svm_trampoline_llil_i_4,
+//   (void *)svm_trampoline_llil_i_5,
+//   (void *)svm_trampoline_llil_i_6,
+//   (void *)svm_trampoline_llil_i_7,
+//   (void *)svm_trampoline_llil_i_8,
+//   (void *)svm_trampoline_llil_i_9,
+//   (void *)svm_trampoline_llil_i_10,
+//   (void *)svm_trampoline_llil_i_11,
+//   (void *)svm_trampoline_llil_i_12,
+//   (void *)svm_trampoline_llil_i_13,
+//   (void *)svm_trampoline_llil_i_14,
+//   (void *)svm_trampoline_llil_i_15,
+//   (void *)svm_trampoline_llil_l_0,
+//   (void *)svm_trampoline_llil_l_1,
+//   (void *)svm_trampoline_llil_l_2,
+//   (void *)svm_trampoline_llil_l_3,
+//   (void *)svm_trampoline_llil_l_4,
+//   (void *)svm_trampoline_llil_l_5,
+//   (void *)svm_trampoline_llil_l_6,
+//   (void *)svm_trampoline_llil_l_7,
+//   (void *)svm_trampoline_llil_l_8,
+//   (void *)svm_trampoline_llil_l_9,
+//   (void *)svm_trampoline_llil_l_10,
+//   (void *)svm_trampoline_llil_l_11,
+//   (void *)svm_trampoline_llil_l_12,
+//   (void *)svm_trampoline_llil_l_13,
+//   (void *)svm_trampoline_llil_l_14,
+//   (void *)svm_trampoline_llil_l_15,
+//   (void *)svm_trampoline_lll_v_0,
+//   (void *)svm_trampoline_lll_v_1,
+//   (void *)svm_trampoline_lll_v_2,
+//   (void *)svm_trampoline_lll_v_3,
+//   (void *)svm_trampoline_lll_v_4,
+//   (void *)svm_trampoline_lll_v_5,
+//   (void *)svm_trampoline_lll_v_6,
+//   (void *)svm_trampoline_lll_v_7,
+//   (void *)svm_trampoline_lll_v_8,
+//   (void *)svm_trampoline_lll_v_9,
+//   (void *)svm_trampoline_lll_v_10,
+//   (void *)svm_trampoline_lll_v_11,
+//   (void *)svm_trampoline_lll_v_12,
+//   (void *)svm_trampoline_lll_v_13,
+//   (void *)svm_trampoline_lll_v_14,
+//   (void *)svm_trampoline_lll_v_15,
+//   (void *)svm_trampoline_lll_i_0,
+//   (void *)svm_trampoline_lll_i_1,
+//   (void *)svm_trampoline_lll_i_2,
+//   (void *)svm_trampoline_lll_i_3,
+//   (void *)svm_trampoline_lll_i_4,
+//   (void *)svm_trampoline_lll_i_5,
+//   (void *)svm_trampoline_lll_i_6,
+//   (void *)svm_trampoline_lll_i_7,
+//   (void *)svm_trampoline_lll_i_8,
+//   (void *)svm_trampoline_lll_i_9,
+//   (void *)svm_trampoline_lll_i_10,
+//   (void *)svm_trampoline_lll_i_11,
+//   (void *)svm_trampoline_lll_i_12,
+//   (void *)svm_trampoline_lll_i_13,
+//   (void *)svm_trampoline_lll_i_14,
+//   (void *)svm_trampoline_lll_i_15,
+//   (void *)svm_trampoline_lll_l_0,
+//   (void *)svm_trampoline_lll_l_1,
+//   (void *)svm_trampoline_lll_l_2,
+//   (void *)svm_trampoline_lll_l_3,
+//   (void *)svm_trampoline_lll_l_4,
+//   (void *)svm_trampoline_lll_l_5,
+//   (void *)svm_trampoline_lll_l_6,
+//   (void *)svm_trampoline_lll_l_7,
+//   (void *)svm_trampoline_lll_l_8,
+//   (void *)svm_trampoline_lll_l_9,
+//   (void *)svm_trampoline_lll_l_10,
+//   (void *)svm_trampoline_lll_l_11,
+//   (void *)svm_trampoline_lll_l_12,
+//   (void *)svm_trampoline_lll_l_13,
+//   (void *)svm_trampoline_lll_l_14,
+//   (void *)svm_trampoline_lll_l_15,
+//   (void *)svm_trampoline_llli_v_0,
+//   (void *)svm_trampoline_llli_v_1,
+//   (void *)svm_trampoline_llli_v_2,
+//   (void *)svm_trampoline_llli_v_3,
+//   (void *)svm_trampoline_llli_v_4,
+//   (void *)svm_trampoline_llli_v_5,
+//   (void *)svm_trampoline_llli_v_6,
+//   (void *)svm_trampoline_llli_v_7,
+//   (void *)svm_trampoline_llli_v_8,
+//   (void *)svm_trampoline_llli_v_9,
+//   (void *)svm_trampoline_llli_v_10,
+//   (void *)svm_trampoline_llli_v_11,
+//   (void *)svm_trampoline_llli_v_12,
+//   (void *)svm_trampoline_llli_v_13,
+//   (void *)svm_trampoline_llli_v_14,
+//   (void *)svm_trampoline_llli_v_15,
+//   (void *)svm_trampoline_llli_i_0,
+//   (void *)svm_trampoline_llli_i_1,
+//   (void *)svm_trampoline_llli_i_2,
+//   (void *)svm_trampoline_llli_i_3,
+//   (void *)svm_trampoline_llli_i_4,
+//   (void *)svm_trampoline_llli_i_5,
+//   (void *)svm_trampoline_llli_i_6,
+//   (void *)svm_trampoline_llli_i_7,
+//   (void *)svm_trampoline_llli_i_8,
+//   (void *)svm_trampoline_llli_i_9,
+//   (void *)svm_trampoline_llli_i_10,
+//   (void *)svm_trampoline_llli_i_11,
+//   (void *)svm_trampoline_llli_i_12,
+//   (void *)svm_trampoline_llli_i_13,
+//   (void *)svm_trampoline_llli_i_14,
+//   (void *)svm_trampoline_llli_i_15,
+//   (void *)svm_trampoline_llli_l_0,
+//   (void *)svm_trampoline_llli_l_1,
+//   (void *)svm_trampoline_llli_l_2,
+//   (void *)svm_trampoline_llli_l_3,
+//   (void *)svm_trampoline_llli_l_4,
+//   (void *)svm_trampoline_llli_l_5,
+//   (void *)svm_trampoline_llli_l_6,
+//   (void *)svm_trampoline_llli_l_7,
+//   (void *)svm_trampoline_llli_l_8,
+//   (void *)svm_trampoline_llli_l_9,
+//   (void *)svm_trampoline_llli_l_10,
+//   (void *)svm_trampoline_llli_l_11,
+//   (void *)svm_trampoline_llli_l_12,
+//   (void *)svm_trampoline_llli_l_13,
+//   (void *)svm_trampoline_llli_l_14,
+//   (void *)svm_trampoline_llli_l_15,
+//   (void *)svm_trampoline_llll_v_0,
+//   (void *)svm_trampoline_llll_v_1,
+//   (void *)svm_trampoline_llll_v_2,
+//   (void *)svm_trampoline_llll_v_3,
+//   (void *)svm_trampoline_llll_v_4,
+//   (void *)svm_trampoline_llll_v_5,
+//   (void *)svm_trampoline_llll_v_6,
+//   (void *)svm_trampoline_llll_v_7,
+//   (void *)svm_trampoline_llll_v_8,
+//   (void *)svm_trampoline_llll_v_9,
+//   (void *)svm_trampoline_llll_v_10,
+//   (void *)svm_trampoline_llll_v_11,
+//   (void *)svm_trampoline_llll_v_12,
+//   (void *)svm_trampoline_llll_v_13,
+//   (void *)svm_trampoline_llll_v_14,
+//   (void *)svm_trampoline_llll_v_15,
+//   (void *)svm_trampoline_llll_i_0,
+//   (void *)svm_trampoline_llll_i_1,
+//   (void *)svm_trampoline_llll_i_2,
+//   (void *)svm_trampoline_llll_i_3,
+//   (void *)svm_trampoline_llll_i_4,
+//   (void *)svm_trampoline_llll_i_5,
+//   (void *)svm_trampoline_llll_i_6,
+//   (void *)svm_trampoline_llll_i_7,
+//   (void *)svm_trampoline_llll_i_8,
+//   (void *)svm_trampoline_llll_i_9,
+//   (void *)svm_trampoline_llll_i_10,
+//   (void *)svm_trampoline_llll_i_11,
+//   (void *)svm_trampoline_llll_i_12,
+//   (void *)svm_trampoline_llll_i_13,
+//   (void *)svm_trampoline_llll_i_14,
+//   (void *)svm_trampoline_llll_i_15,
+//   (void *)svm_trampoline_llll_l_0,
+//   (void *)svm_trampoline_llll_l_1,
+//   (void *)svm_trampoline_llll_l_2,
+//   (void *)svm_trampoline_llll_l_3,
+//   (void *)svm_trampoline_llll_l_4,
+//   (void *)svm_trampoline_llll_l_5,
+//   (void *)svm_trampoline_llll_l_6,
+//   (void *)svm_trampoline_llll_l_7,
+//   (void *)svm_trampoline_llll_l_8,
+//   (void *)svm_trampoline_llll_l_9,
+//   (void *)svm_trampoline_llll_l_10,
+//   (void *)svm_trampoline_llll_l_11,
+//   (void *)svm_trampoline_llll_l_12,
+//   (void *)svm_trampoline_llll_l_13,
+//   (void *)svm_trampoline_llll_l_14,
+//   (void *)svm_trampoline_llll_l_15,
+// };
+//
+// static void *svm_trampoline(int slot) { return svm_trampolines[slot]; }
+import "C"
+
+import "unsafe"
+
+// trampolineSlotsPerShape is the number of trampolines generated per signature shape,
+// which is the max number of closure import functions sharing the same shape at any given time.
+const trampolineSlotsPerShape = 16
+
+// trampolineShapes lists the supported signature shapes, ordered by their slots range.
+var trampolineShapes = []trampolineShape{
+	{ValueTypes{}, ValueTypes{}},
+	{ValueTypes{}, ValueTypes{TypeI32}},
+	{ValueTypes{}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI32, TypeI32, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI32, TypeI64, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI64, TypeI32, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI32, TypeI64, TypeI64, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI32, TypeI32, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI32, TypeI64, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI64, TypeI32, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64, TypeI32}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64, TypeI32}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64, TypeI32}, ValueTypes{TypeI64}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64, TypeI64}, ValueTypes{}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64, TypeI64}, ValueTypes{TypeI32}},
+	{ValueTypes{TypeI64, TypeI64, TypeI64, TypeI64}, ValueTypes{TypeI64}},
+}
+
+func cTrampoline(slot int) unsafe.Pointer {
+	return C.svm_trampoline(C.int(slot))
+}
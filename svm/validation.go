@@ -1,13 +1,31 @@
 package svm
 
 func ValidateTemplate(runtime Runtime, appTemplate []byte) error {
-	return cSvmValidateTemplate(runtime, appTemplate)
+	p, err := runtime.enter(OpValidate)
+	if err != nil {
+		return err
+	}
+	defer runtime.exit()
+
+	return cSvmValidateTemplate(p, appTemplate)
 }
 
 func ValidateApp(runtime Runtime, app []byte) error {
-	return cSvmValidateApp(runtime, app)
+	p, err := runtime.enter(OpValidate)
+	if err != nil {
+		return err
+	}
+	defer runtime.exit()
+
+	return cSvmValidateApp(p, app)
 }
 
 func ValidateAppTx(runtime Runtime, appTx []byte) (Address, error) {
-	return cSvmValidateTx(runtime, appTx)
+	p, err := runtime.enter(OpValidate)
+	if err != nil {
+		return Address{}, err
+	}
+	defer runtime.exit()
+
+	return cSvmValidateTx(p, appTx)
 }
@@ -0,0 +1,255 @@
+package svm
+
+import (
+	"encoding/json"
+	"flag"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The test vectors of the `codec` package are recorded out of the SVM runtime library,
+// so its pure-Go encoding and receipt parsers can be checked against the runtime without cgo:
+//
+//	go test ./svm -run TestRecordVectors -record-vectors
+//
+// The vectors must be recorded again whenever the SVM runtime revision is bumped.
+var recordVectors = flag.Bool("record-vectors", false, "record the codec test vectors out of the SVM runtime")
+
+const (
+	vectorsDir       = "codec/testdata"
+	vectorsPerFormat = 200
+)
+
+// encodingVectors mirrors the `encoding_vectors.json` layout read by the `codec` package tests.
+type encodingVectors struct {
+	AppTemplates []appTemplateVector `json:"app_templates"`
+	SpawnApps    []callVector        `json:"spawn_apps"`
+	AppTxs       []callVector        `json:"app_txs"`
+}
+
+type appTemplateVector struct {
+	Version    int        `json:"version"`
+	Name       string     `json:"name"`
+	Code       []byte     `json:"code"`
+	DataLayout DataLayout `json:"data_layout"`
+	Encoded    []byte     `json:"encoded"`
+}
+
+// callVector is a `spawn-app` or an `app-tx` vector. The args are Values encoded.
+type callVector struct {
+	Version    int     `json:"version"`
+	Addr       Address `json:"addr"`
+	FuncIndex  uint16  `json:"func_index"`
+	FuncBuffer []byte  `json:"func_buffer"`
+	FuncArgs   []byte  `json:"func_args"`
+	Encoded    []byte  `json:"encoded"`
+}
+
+// receiptVectors mirrors the `receipt_vectors.json` layout read by the `codec` package tests.
+// The receipt fields are read via the runtime receipt helpers, and are only set on success.
+type receiptVectors struct {
+	Deploys []deployReceiptVector `json:"deploys"`
+	Spawns  []spawnReceiptVector  `json:"spawns"`
+	Execs   []execReceiptVector   `json:"execs"`
+}
+
+type deployReceiptVector struct {
+	Receipt      []byte  `json:"receipt"`
+	Success      bool    `json:"success"`
+	TemplateAddr Address `json:"template_addr"`
+	GasUsed      uint64  `json:"gas_used"`
+}
+
+// spawnReceiptVector is a `spawn-app` receipt vector. The returns are Values encoded.
+type spawnReceiptVector struct {
+	Receipt      []byte  `json:"receipt"`
+	Success      bool    `json:"success"`
+	AppAddr      Address `json:"app_addr"`
+	InitialState []byte  `json:"initial_state"`
+	Returns      []byte  `json:"returns"`
+	GasUsed      uint64  `json:"gas_used"`
+}
+
+// execReceiptVector is an `exec-app` receipt vector. The returns are Values encoded.
+type execReceiptVector struct {
+	Receipt  []byte `json:"receipt"`
+	Success  bool   `json:"success"`
+	NewState []byte `json:"new_state"`
+	Returns  []byte `json:"returns"`
+	GasUsed  uint64 `json:"gas_used"`
+}
+
+func randName(r *rand.Rand, maxLen int) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz_"
+
+	b := make([]byte, r.Intn(maxLen+1))
+	for i := range b {
+		b[i] = letters[r.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+func writeVectors(req *require.Assertions, name string, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	req.NoError(err)
+	req.NoError(os.MkdirAll(vectorsDir, 0755))
+	req.NoError(ioutil.WriteFile(filepath.Join(vectorsDir, name), append(data, '\n'), 0644))
+}
+
+func TestRecordVectors(t *testing.T) {
+	if !*recordVectors {
+		t.Skip("run with -record-vectors to record the codec test vectors")
+	}
+
+	req := require.New(t)
+	r := rand.New(rand.NewSource(1))
+
+	vectors := encodingVectors{}
+
+	// Edge cases first, and then randomized inputs.
+	templates := []appTemplateVector{
+		{Name: "", Code: []byte{}, DataLayout: DataLayout{}},
+		{Version: 1, Name: "counter", Code: []byte{0}, DataLayout: DataLayout{4}},
+	}
+	for i := 0; i < vectorsPerFormat; i++ {
+		dataLayout := make(DataLayout, r.Intn(10))
+		for j := range dataLayout {
+			dataLayout[j] = r.Uint32()
+		}
+		templates = append(templates, appTemplateVector{
+			Version:    r.Intn(3),
+			Name:       randName(r, 64),
+			Code:       randBytes(r, 256),
+			DataLayout: dataLayout,
+		})
+	}
+	for _, v := range templates {
+		encoded, err := EncodeAppTemplate(v.Version, v.Name, v.Code, v.DataLayout)
+		req.NoError(err)
+		v.Encoded = encoded
+		vectors.AppTemplates = append(vectors.AppTemplates, v)
+	}
+
+	calls := []callVector{
+		{FuncArgs: Values{}.Encode()},
+		{Version: 1, FuncIndex: 1<<16 - 1, FuncBuffer: []byte{0}, FuncArgs: Values{I32(-1), I64(-1)}.Encode()},
+	}
+	for i := 0; i < vectorsPerFormat; i++ {
+		calls = append(calls, callVector{
+			Version:    r.Intn(3),
+			Addr:       randAddress(r),
+			FuncIndex:  uint16(r.Intn(1 << 16)),
+			FuncBuffer: randBytes(r, 256),
+			FuncArgs:   randValues(r).Encode(),
+		})
+	}
+	for _, v := range calls {
+		var args Values
+		req.NoError(args.Decode(v.FuncArgs))
+
+		encoded, err := EncodeSpawnApp(v.Version, v.Addr, v.FuncIndex, v.FuncBuffer, args)
+		req.NoError(err)
+		spawnApp := v
+		spawnApp.Encoded = encoded
+		vectors.SpawnApps = append(vectors.SpawnApps, spawnApp)
+
+		encoded, err = EncodeAppTx(v.Version, v.Addr, v.FuncIndex, v.FuncBuffer, args)
+		req.NoError(err)
+		appTx := v
+		appTx.Encoded = encoded
+		vectors.AppTxs = append(vectors.AppTxs, appTx)
+	}
+
+	writeVectors(req, "encoding_vectors.json", vectors)
+
+	recordReceiptVectors(req)
+}
+
+// recordReceiptVectors records the receipts of successful and failed transactions,
+// with and without gas metering.
+func recordReceiptVectors(req *require.Assertions) {
+	runtime, free := newTestRuntime(req)
+	defer free()
+
+	vectors := receiptVectors{}
+
+	gasOptions := []struct {
+		metering bool
+		limit    uint64
+	}{
+		{false, 0},
+		{true, 10000000},
+		{true, 1},
+	}
+
+	code, err := ioutil.ReadFile("testdata/storage_template.wasm")
+	req.NoError(err)
+	appTemplate, err := EncodeAppTemplate(0, "storage", code, DataLayout{4})
+	req.NoError(err)
+
+	// A failed deploy has no result, so its receipt is read via the bridge.
+	for _, gas := range gasOptions {
+		receipt, err := cSvmDeployTemplate(runtime.h.p, appTemplate, Address{}, emptyHostCtx(), gas.metering, gas.limit)
+		req.NoError(err)
+
+		v := deployReceiptVector{Receipt: receipt}
+		if res, err := readDeployReceipt(receipt); err == nil {
+			v.Success, v.TemplateAddr, v.GasUsed = true, res.TemplateAddr, res.GasUsed
+		}
+		vectors.Deploys = append(vectors.Deploys, v)
+	}
+
+	templateAddr := deployTestTemplate(req, runtime)
+	app := spawnTestApp(req, runtime, templateAddr, 5)
+
+	for _, ctorIndex := range []uint16{storageIncFuncIndex, 100} {
+		spawnApp, err := EncodeSpawnApp(0, templateAddr, ctorIndex, nil, Values{I32(5)})
+		req.NoError(err)
+
+		for _, gas := range gasOptions {
+			res, err := SpawnApp(runtime, spawnApp, Address{}, emptyHostCtx(), gas.metering, gas.limit)
+			req.NoError(err)
+
+			vectors.Spawns = append(vectors.Spawns, spawnReceiptVector{
+				Receipt:      res.Receipt,
+				Success:      res.Success,
+				AppAddr:      res.AppAddr,
+				InitialState: res.InitialState,
+				Returns:      res.Returns.Encode(),
+				GasUsed:      res.GasUsed,
+			})
+		}
+	}
+
+	calls := []struct {
+		funcIndex uint16
+		args      Values
+	}{
+		{storageIncFuncIndex, Values{I32(3)}},
+		{storageGetFuncIndex, nil},
+		{100, nil},
+	}
+	for _, call := range calls {
+		appTx, err := EncodeAppTx(0, app.AppAddr, call.funcIndex, nil, call.args)
+		req.NoError(err)
+
+		for _, gas := range gasOptions {
+			res, err := ExecApp(runtime, appTx, app.InitialState, emptyHostCtx(), gas.metering, gas.limit)
+			req.NoError(err)
+
+			vectors.Execs = append(vectors.Execs, execReceiptVector{
+				Receipt:  res.Receipt,
+				Success:  res.Success,
+				NewState: res.NewState,
+				Returns:  res.Returns.Encode(),
+				GasUsed:  res.GasUsed,
+			})
+		}
+	}
+
+	writeVectors(req, "receipt_vectors.json", vectors)
+}
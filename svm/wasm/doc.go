@@ -0,0 +1,6 @@
+// Package wasm implements a WebAssembly binary format parser in pure Go.
+//
+// It decodes the declarations of a module (its types, imports, functions signatures,
+// tables, memories, globals and exports), so that app templates can be inspected
+// before they are deployed. The functions bodies and the data are not decoded.
+package wasm
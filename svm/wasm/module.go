@@ -0,0 +1,478 @@
+package wasm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ValueType is a WebAssembly value type.
+type ValueType byte
+
+const (
+	I32 ValueType = 0x7f
+	I64 ValueType = 0x7e
+	F32 ValueType = 0x7d
+	F64 ValueType = 0x7c
+)
+
+// String helps ValueType to implement the Stringer interface.
+func (t ValueType) String() string {
+	switch t {
+	case I32:
+		return "i32"
+	case I64:
+		return "i64"
+	case F32:
+		return "f32"
+	case F64:
+		return "f64"
+	default:
+		return fmt.Sprintf("ValueType(0x%02x)", byte(t))
+	}
+}
+
+// FuncType is a function signature.
+type FuncType struct {
+	Params  []ValueType
+	Results []ValueType
+}
+
+// Equal reports whether both signatures are the same.
+func (t FuncType) Equal(other FuncType) bool {
+	return equalValueTypes(t.Params, other.Params) && equalValueTypes(t.Results, other.Results)
+}
+
+func equalValueTypes(a, b []ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String helps FuncType to implement the Stringer interface.
+// The signature is formatted like `(i32, i32) -> i32`.
+func (t FuncType) String() string {
+	results := formatValueTypes(t.Results)
+	if len(t.Results) == 1 {
+		results = t.Results[0].String()
+	}
+
+	return fmt.Sprintf("%v -> %v", formatValueTypes(t.Params), results)
+}
+
+func formatValueTypes(types []ValueType) string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = t.String()
+	}
+	return "(" + strings.Join(s, ", ") + ")"
+}
+
+// ExternalKind is the kind of an import or an export.
+type ExternalKind byte
+
+const (
+	KindFunc   ExternalKind = 0x00
+	KindTable  ExternalKind = 0x01
+	KindMemory ExternalKind = 0x02
+	KindGlobal ExternalKind = 0x03
+)
+
+// String helps ExternalKind to implement the Stringer interface.
+func (k ExternalKind) String() string {
+	switch k {
+	case KindFunc:
+		return "func"
+	case KindTable:
+		return "table"
+	case KindMemory:
+		return "memory"
+	case KindGlobal:
+		return "global"
+	default:
+		return fmt.Sprintf("ExternalKind(0x%02x)", byte(k))
+	}
+}
+
+// Limits are the size limits of a table (in elements) or a memory (in 64KiB pages).
+type Limits struct {
+	Min    uint32
+	Max    uint32
+	HasMax bool
+}
+
+// String helps Limits to implement the Stringer interface.
+func (l Limits) String() string {
+	if !l.HasMax {
+		return fmt.Sprintf("min: %v", l.Min)
+	}
+	return fmt.Sprintf("min: %v, max: %v", l.Min, l.Max)
+}
+
+// GlobalType is the type of a global variable.
+type GlobalType struct {
+	Type    ValueType
+	Mutable bool
+}
+
+// String helps GlobalType to implement the Stringer interface.
+func (t GlobalType) String() string {
+	if t.Mutable {
+		return fmt.Sprintf("mut %v", t.Type)
+	}
+	return t.Type.String()
+}
+
+// Import is an import of a module.
+type Import struct {
+	// The import namespace, something like `env`.
+	Module string
+	Name   string
+	Kind   ExternalKind
+
+	// The signature of a function import.
+	Func FuncType
+
+	// The limits of a table or a memory import.
+	Limits Limits
+
+	// The type of a global import.
+	Global GlobalType
+}
+
+// QualifiedName returns the namespace-qualified name of the import, something like `env.inc`.
+func (i Import) QualifiedName() string {
+	return i.Module + "." + i.Name
+}
+
+// String helps Import to implement the Stringer interface.
+func (i Import) String() string {
+	switch i.Kind {
+	case KindFunc:
+		return fmt.Sprintf("func %v %v", i.QualifiedName(), i.Func)
+	case KindTable, KindMemory:
+		return fmt.Sprintf("%v %v (%v)", i.Kind, i.QualifiedName(), i.Limits)
+	default:
+		return fmt.Sprintf("%v %v %v", i.Kind, i.QualifiedName(), i.Global)
+	}
+}
+
+// Export is an export of a module.
+type Export struct {
+	Name string
+	Kind ExternalKind
+
+	// The index of the exported definition, in the index space of its kind.
+	Index uint32
+}
+
+// Module holds the declarations of a module.
+// The imported definitions precede the defined ones in their index space,
+// e.g. the function of index 0 is the first function import, if any.
+type Module struct {
+	Types   []FuncType
+	Imports []Import
+
+	// The signatures of the functions defined by the module.
+	Funcs []FuncType
+
+	Tables   []Limits
+	Memories []Limits
+	Globals  []GlobalType
+	Exports  []Export
+
+	// The start function index, if HasStart is set.
+	Start    uint32
+	HasStart bool
+}
+
+// ImportedFuncs returns the function imports.
+func (m *Module) ImportedFuncs() []Import {
+	return m.imports(KindFunc)
+}
+
+// ImportedMemories returns the memory imports.
+func (m *Module) ImportedMemories() []Import {
+	return m.imports(KindMemory)
+}
+
+func (m *Module) imports(kind ExternalKind) []Import {
+	var imports []Import
+	for _, imp := range m.Imports {
+		if imp.Kind == kind {
+			imports = append(imports, imp)
+		}
+	}
+	return imports
+}
+
+// FuncType returns the signature of a function, given its index.
+func (m *Module) FuncType(index uint32) (FuncType, bool) {
+	imported := m.ImportedFuncs()
+	if index < uint32(len(imported)) {
+		return imported[index].Func, true
+	}
+
+	index -= uint32(len(imported))
+	if index < uint32(len(m.Funcs)) {
+		return m.Funcs[index], true
+	}
+
+	return FuncType{}, false
+}
+
+// Export returns the export of the given name.
+func (m *Module) Export(name string) (Export, bool) {
+	for _, e := range m.Exports {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return Export{}, false
+}
+
+// header is the binary format magic number (`\0asm`), followed by its version (1).
+var header = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// The binary format sections ids.
+const (
+	sectionCustom    = 0
+	sectionType      = 1
+	sectionImport    = 2
+	sectionFunction  = 3
+	sectionTable     = 4
+	sectionMemory    = 5
+	sectionGlobal    = 6
+	sectionExport    = 7
+	sectionStart     = 8
+	sectionElement   = 9
+	sectionCode      = 10
+	sectionData      = 11
+	sectionDataCount = 12
+)
+
+// sectionOrder is the rank of each non-custom section, which must occur in increasing rank.
+var sectionOrder = map[byte]int{
+	sectionType:      1,
+	sectionImport:    2,
+	sectionFunction:  3,
+	sectionTable:     4,
+	sectionMemory:    5,
+	sectionGlobal:    6,
+	sectionExport:    7,
+	sectionStart:     8,
+	sectionElement:   9,
+	sectionDataCount: 10,
+	sectionCode:      11,
+	sectionData:      12,
+}
+
+// Parse decodes the declarations of a module in the WebAssembly binary format.
+func Parse(code []byte) (*Module, error) {
+	r := &reader{b: code}
+	if !bytes.HasPrefix(code, header[:4]) {
+		return nil, r.errorf("invalid magic number")
+	}
+	if !bytes.HasPrefix(code, header) {
+		r.off = 4
+		return nil, r.errorf("unsupported version")
+	}
+	r.off = len(header)
+
+	m := &Module{}
+	rank := 0
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		s, err := r.sub(size)
+		if err != nil {
+			return nil, err
+		}
+
+		if id == sectionCustom {
+			continue
+		}
+
+		next, ok := sectionOrder[id]
+		if !ok {
+			return nil, s.errorf("invalid section id %v", id)
+		}
+		if next <= rank {
+			return nil, s.errorf("unexpected section id %v, out of order", id)
+		}
+		rank = next
+
+		if err := m.parseSection(id, s); err != nil {
+			return nil, err
+		}
+		if !s.done() {
+			return nil, s.errorf("section size mismatch")
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Module) parseSection(id byte, r *reader) error {
+	switch id {
+	case sectionType:
+		return vector(r, func() error {
+			t, err := r.funcType()
+			m.Types = append(m.Types, t)
+			return err
+		})
+	case sectionImport:
+		return vector(r, func() error {
+			imp, err := m.parseImport(r)
+			m.Imports = append(m.Imports, imp)
+			return err
+		})
+	case sectionFunction:
+		return vector(r, func() error {
+			t, err := m.typeAt(r)
+			m.Funcs = append(m.Funcs, t)
+			return err
+		})
+	case sectionTable:
+		return vector(r, func() error {
+			l, err := r.tableType()
+			m.Tables = append(m.Tables, l)
+			return err
+		})
+	case sectionMemory:
+		return vector(r, func() error {
+			l, err := r.limits()
+			m.Memories = append(m.Memories, l)
+			return err
+		})
+	case sectionGlobal:
+		return vector(r, func() error {
+			t, err := r.globalType()
+			if err != nil {
+				return err
+			}
+			m.Globals = append(m.Globals, t)
+			return r.skipConstExpr()
+		})
+	case sectionExport:
+		return vector(r, func() error {
+			e, err := parseExport(r)
+			m.Exports = append(m.Exports, e)
+			return err
+		})
+	case sectionStart:
+		start, err := r.u32()
+		m.Start, m.HasStart = start, true
+		return err
+	case sectionCode:
+		n, err := r.u32()
+		if err != nil {
+			return err
+		}
+		if n != uint32(len(m.Funcs)) {
+			return r.errorf("function and code section have inconsistent lengths")
+		}
+	}
+
+	// The bodies and the segments aren't decoded.
+	r.off = len(r.b)
+	return nil
+}
+
+// vector decodes a vector, calling fn for each of its elements.
+func vector(r *reader, fn func() error) error {
+	n, err := r.count()
+	if err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < n; i++ {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// typeAt decodes a type index, and returns the indexed type.
+func (m *Module) typeAt(r *reader) (FuncType, error) {
+	idx, err := r.u32()
+	if err != nil {
+		return FuncType{}, err
+	}
+	if idx >= uint32(len(m.Types)) {
+		return FuncType{}, r.errorf("type index %v is out of range", idx)
+	}
+
+	return m.Types[idx], nil
+}
+
+func (m *Module) parseImport(r *reader) (Import, error) {
+	var imp Import
+	var err error
+
+	if imp.Module, err = r.name(); err != nil {
+		return imp, err
+	}
+	if imp.Name, err = r.name(); err != nil {
+		return imp, err
+	}
+
+	kind, err := r.byte()
+	if err != nil {
+		return imp, err
+	}
+
+	imp.Kind = ExternalKind(kind)
+	switch imp.Kind {
+	case KindFunc:
+		imp.Func, err = m.typeAt(r)
+	case KindTable:
+		imp.Limits, err = r.tableType()
+	case KindMemory:
+		imp.Limits, err = r.limits()
+	case KindGlobal:
+		imp.Global, err = r.globalType()
+	default:
+		r.off--
+		err = r.errorf("invalid import kind 0x%02x", kind)
+	}
+
+	return imp, err
+}
+
+func parseExport(r *reader) (Export, error) {
+	var e Export
+	var err error
+
+	if e.Name, err = r.name(); err != nil {
+		return e, err
+	}
+
+	kind, err := r.byte()
+	if err != nil {
+		return e, err
+	}
+
+	e.Kind = ExternalKind(kind)
+	if e.Kind > KindGlobal {
+		r.off--
+		return e, r.errorf("invalid export kind 0x%02x", kind)
+	}
+
+	e.Index, err = r.u32()
+	return e, err
+}
@@ -0,0 +1,266 @@
+package wasm
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// reader decodes the binary format primitives.
+type reader struct {
+	b   []byte
+	off int
+
+	// The offset of b within the module, for errors.
+	base int
+}
+
+func (r *reader) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("malformed wasm module at offset %v: %v", r.base+r.off, fmt.Sprintf(format, a...))
+}
+
+func (r *reader) done() bool {
+	return r.off == len(r.b)
+}
+
+func (r *reader) byte() (byte, error) {
+	if r.done() {
+		return 0, r.errorf("unexpected end")
+	}
+
+	b := r.b[r.off]
+	r.off++
+	return b, nil
+}
+
+func (r *reader) bytes(n uint32) ([]byte, error) {
+	if uint64(n) > uint64(len(r.b)-r.off) {
+		return nil, r.errorf("unexpected end")
+	}
+
+	b := r.b[r.off : r.off+int(n)]
+	r.off += int(n)
+	return b, nil
+}
+
+// sub returns a reader of the next n bytes.
+func (r *reader) sub(n uint32) (*reader, error) {
+	base := r.base + r.off
+	b, err := r.bytes(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reader{b: b, base: base}, nil
+}
+
+// leb decodes an LEB128 integer of the given bit size, and returns its raw bits.
+func (r *reader) leb(bitSize uint, signed bool) (uint64, error) {
+	var v uint64
+	var shift uint
+
+	for {
+		b, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+
+		v |= uint64(b&0x7f) << shift
+		shift += 7
+
+		if b&0x80 == 0 {
+			if signed && shift < 64 && b&0x40 != 0 {
+				v |= ^uint64(0) << shift
+			}
+			return v, nil
+		}
+		if shift >= bitSize {
+			return 0, r.errorf("integer representation too long")
+		}
+	}
+}
+
+func (r *reader) u32() (uint32, error) {
+	v, err := r.leb(32, false)
+	if err != nil {
+		return 0, err
+	}
+	if v > 1<<32-1 {
+		return 0, r.errorf("integer too large")
+	}
+
+	return uint32(v), nil
+}
+
+// count decodes the length of a vector, each of whose elements spans at least one byte.
+func (r *reader) count() (uint32, error) {
+	n, err := r.u32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(n) > uint64(len(r.b)-r.off) {
+		return 0, r.errorf("vector length %v is out of bounds", n)
+	}
+
+	return n, nil
+}
+
+func (r *reader) name() (string, error) {
+	n, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+
+	b, err := r.bytes(n)
+	if err != nil {
+		return "", err
+	}
+	if !utf8.Valid(b) {
+		return "", r.errorf("malformed UTF-8 name")
+	}
+
+	return string(b), nil
+}
+
+func (r *reader) valueType() (ValueType, error) {
+	b, err := r.byte()
+	if err != nil {
+		return 0, err
+	}
+
+	t := ValueType(b)
+	switch t {
+	case I32, I64, F32, F64:
+		return t, nil
+	default:
+		r.off--
+		return 0, r.errorf("invalid value type 0x%02x", b)
+	}
+}
+
+func (r *reader) valueTypes() ([]ValueType, error) {
+	n, err := r.count()
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]ValueType, n)
+	for i := range types {
+		if types[i], err = r.valueType(); err != nil {
+			return nil, err
+		}
+	}
+
+	return types, nil
+}
+
+func (r *reader) funcType() (FuncType, error) {
+	form, err := r.byte()
+	if err != nil {
+		return FuncType{}, err
+	}
+	if form != 0x60 {
+		r.off--
+		return FuncType{}, r.errorf("invalid function type form 0x%02x", form)
+	}
+
+	params, err := r.valueTypes()
+	if err != nil {
+		return FuncType{}, err
+	}
+	results, err := r.valueTypes()
+	if err != nil {
+		return FuncType{}, err
+	}
+
+	return FuncType{Params: params, Results: results}, nil
+}
+
+func (r *reader) limits() (Limits, error) {
+	flag, err := r.byte()
+	if err != nil {
+		return Limits{}, err
+	}
+
+	var l Limits
+	switch flag {
+	case 0x00:
+	case 0x01:
+		l.HasMax = true
+	default:
+		r.off--
+		return Limits{}, r.errorf("invalid limits flag 0x%02x", flag)
+	}
+
+	if l.Min, err = r.u32(); err != nil {
+		return Limits{}, err
+	}
+	if l.HasMax {
+		if l.Max, err = r.u32(); err != nil {
+			return Limits{}, err
+		}
+	}
+
+	return l, nil
+}
+
+func (r *reader) tableType() (Limits, error) {
+	elemType, err := r.byte()
+	if err != nil {
+		return Limits{}, err
+	}
+	if elemType != 0x70 {
+		r.off--
+		return Limits{}, r.errorf("invalid table element type 0x%02x", elemType)
+	}
+
+	return r.limits()
+}
+
+func (r *reader) globalType() (GlobalType, error) {
+	t, err := r.valueType()
+	if err != nil {
+		return GlobalType{}, err
+	}
+
+	mut, err := r.byte()
+	if err != nil {
+		return GlobalType{}, err
+	}
+	if mut > 1 {
+		r.off--
+		return GlobalType{}, r.errorf("invalid global mutability 0x%02x", mut)
+	}
+
+	return GlobalType{Type: t, Mutable: mut == 1}, nil
+}
+
+// skipConstExpr skips a constant expression, e.g. a global initializer.
+func (r *reader) skipConstExpr() error {
+	for {
+		op, err := r.byte()
+		if err != nil {
+			return err
+		}
+
+		switch op {
+		case 0x0b: // end
+			return nil
+		case 0x41: // i32.const
+			_, err = r.leb(32, true)
+		case 0x42: // i64.const
+			_, err = r.leb(64, true)
+		case 0x43: // f32.const
+			_, err = r.bytes(4)
+		case 0x44: // f64.const
+			_, err = r.bytes(8)
+		case 0x23: // global.get
+			_, err = r.u32()
+		default:
+			r.off--
+			return r.errorf("invalid constant expression opcode 0x%02x", op)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package wasm
+
+import (
+	"github.com/stretchr/testify/require"
+	"go-svm/svm/wat"
+	"io/ioutil"
+	"testing"
+)
+
+func TestParse_CounterTemplate(t *testing.T) {
+	req := require.New(t)
+
+	code, err := ioutil.ReadFile("../testdata/counter_template.wasm")
+	req.NoError(err)
+
+	m, err := Parse(code)
+	req.NoError(err)
+
+	req.Equal([]Import{
+		{Module: "svm", Name: "get32", Kind: KindFunc, Func: FuncType{Params: []ValueType{I32}, Results: []ValueType{I32}}},
+		{Module: "svm", Name: "set32", Kind: KindFunc, Func: FuncType{Params: []ValueType{I32, I32}, Results: []ValueType{}}},
+		{Module: "env", Name: "inc", Kind: KindFunc, Func: FuncType{Params: []ValueType{I32}, Results: []ValueType{}}},
+		{Module: "env", Name: "get", Kind: KindFunc, Func: FuncType{Params: []ValueType{}, Results: []ValueType{I32}}},
+	}, m.Imports)
+	req.Len(m.ImportedFuncs(), 4)
+	req.Empty(m.ImportedMemories())
+
+	req.Len(m.Funcs, 4)
+	req.Equal([]Limits{{Min: 1}}, m.Memories)
+	req.Equal([]Export{
+		{Name: "storage_inc", Kind: KindFunc, Index: 4},
+		{Name: "storage_get", Kind: KindFunc, Index: 5},
+		{Name: "host_inc", Kind: KindFunc, Index: 6},
+		{Name: "host_get", Kind: KindFunc, Index: 7},
+	}, m.Exports)
+
+	e, ok := m.Export("host_get")
+	req.True(ok)
+	sig, ok := m.FuncType(e.Index)
+	req.True(ok)
+	req.Equal("() -> i32", sig.String())
+
+	sig, ok = m.FuncType(1)
+	req.True(ok)
+	req.Equal("(i32, i32) -> ()", sig.String())
+	req.Equal("func svm.set32 (i32, i32) -> ()", m.Imports[1].String())
+
+	_, ok = m.FuncType(8)
+	req.False(ok)
+	_, ok = m.Export("missing")
+	req.False(ok)
+}
+
+func TestParse_Declarations(t *testing.T) {
+	req := require.New(t)
+
+	code, err := wat.Compile([]byte(`(module
+		(import "env" "mem" (memory 1 4))
+		(import "env" "counter" (global $counter (mut i64)))
+		(table (export "table") 2 funcref)
+		(global (export "pi") f64 (f64.const 3.14))
+		(global i32 (global.get $counter))
+		(func $start)
+		(start $start)
+		(elem (i32.const 0) $start)
+		(data (i32.const 0) "data"))`))
+	req.NoError(err)
+
+	m, err := Parse(code)
+	req.NoError(err)
+
+	req.Equal("memory env.mem (min: 1, max: 4)", m.Imports[0].String())
+	req.Equal("global env.counter mut i64", m.Imports[1].String())
+	req.Len(m.ImportedMemories(), 1)
+	req.Empty(m.ImportedFuncs())
+
+	req.Equal([]Limits{{Min: 2}}, m.Tables)
+	req.Empty(m.Memories)
+	req.Equal([]GlobalType{{Type: F64}, {Type: I32}}, m.Globals)
+	req.Equal([]Export{{Name: "table", Kind: KindTable}, {Name: "pi", Kind: KindGlobal, Index: 1}}, m.Exports)
+	req.True(m.HasStart)
+	req.Equal(uint32(0), m.Start)
+}
+
+func TestParse_Malformed(t *testing.T) {
+	req := require.New(t)
+
+	valid, err := ioutil.ReadFile("../testdata/storage_template.wasm")
+	req.NoError(err)
+
+	tests := []struct {
+		code []byte
+		err  string
+	}{
+		{[]byte{0x00, 0x61, 0x73}, "malformed wasm module at offset 0: invalid magic number"},
+		{[]byte{0x00, 0x61, 0x73, 0x6d, 0x02, 0x00, 0x00, 0x00}, "malformed wasm module at offset 4: unsupported version"},
+		{valid[:len(valid)-1], "malformed wasm module at offset 99: unexpected end"},
+		{append(append([]byte{}, header...), sectionImport, 0x01, 0x00, sectionType, 0x01, 0x00),
+			"malformed wasm module at offset 13: unexpected section id 1, out of order"},
+		{append(append([]byte{}, header...), sectionType, 0x02, 0x00, 0x00),
+			"malformed wasm module at offset 11: section size mismatch"},
+		{append(append([]byte{}, header...), sectionFunction, 0x02, 0x01, 0x00),
+			"malformed wasm module at offset 12: type index 0 is out of range"},
+		{append(append([]byte{}, header...), sectionType, 0x04, 0x01, 0x60, 0x01, 0x70),
+			"malformed wasm module at offset 13: invalid value type 0x70"},
+		{append(append([]byte{}, header...), 0x0d, 0x00),
+			"malformed wasm module at offset 10: invalid section id 13"},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.code)
+		req.EqualError(err, test.err, "%x", test.code)
+	}
+}
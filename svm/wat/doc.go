@@ -0,0 +1,10 @@
+// Package wat implements a WebAssembly text format (WAT) assembler in pure Go.
+//
+// It compiles the modules of the WebAssembly MVP, written either in the flat or the
+// folded instructions syntax, into their binary format, so that app templates can be
+// kept as .wat sources and deployed without an external wat2wasm tool.
+// The legacy instruction names (e.g. `get_local`, `i32.wrap/i64`) are accepted
+// alongside the current ones.
+//
+// The output matches the one of wat2wasm, without the optional names section.
+package wat
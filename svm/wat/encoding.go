@@ -0,0 +1,231 @@
+package wat
+
+// The binary format sections ids, in the order they must occur.
+const (
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionTable    = 4
+	sectionMemory   = 5
+	sectionGlobal   = 6
+	sectionExport   = 7
+	sectionStart    = 8
+	sectionElement  = 9
+	sectionCode     = 10
+	sectionData     = 11
+)
+
+// header is the binary format magic number (`\0asm`), followed by its version (1).
+var header = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+// encode encodes the resolved module into its binary format.
+// The empty sections are omitted.
+func (m *module) encode() []byte {
+	out := append([]byte{}, header...)
+
+	var section []byte
+	for _, t := range m.types {
+		section = append(section, funcTypeForm)
+		section = appendValTypes(section, t.params)
+		section = appendValTypes(section, t.results)
+	}
+	out = appendSection(out, sectionType, len(m.types), section)
+
+	section = nil
+	for _, imp := range m.imports {
+		section = appendName(section, imp.module)
+		section = appendName(section, imp.name)
+		section = append(section, imp.kind)
+
+		switch imp.kind {
+		case kindFunc:
+			section = appendU32(section, imp.typeIdx)
+		case kindTable:
+			section = append(section, funcRefType)
+			section = appendLimits(section, imp.limits)
+		case kindMemory:
+			section = appendLimits(section, imp.limits)
+		default:
+			section = appendGlobalType(section, imp.global)
+		}
+	}
+	out = appendSection(out, sectionImport, len(m.imports), section)
+
+	section = nil
+	for _, fn := range m.funcs {
+		section = appendU32(section, fn.typeIdx)
+	}
+	out = appendSection(out, sectionFunction, len(m.funcs), section)
+
+	section = nil
+	for _, l := range m.tables {
+		section = append(section, funcRefType)
+		section = appendLimits(section, l)
+	}
+	out = appendSection(out, sectionTable, len(m.tables), section)
+
+	section = nil
+	for _, l := range m.memories {
+		section = appendLimits(section, l)
+	}
+	out = appendSection(out, sectionMemory, len(m.memories), section)
+
+	section = nil
+	for _, g := range m.globals {
+		section = appendGlobalType(section, g.globalType)
+		section = append(section, g.code...)
+	}
+	out = appendSection(out, sectionGlobal, len(m.globals), section)
+
+	section = nil
+	for _, e := range m.exports {
+		section = appendName(section, e.name)
+		section = append(section, e.kind)
+		section = appendU32(section, e.index)
+	}
+	out = appendSection(out, sectionExport, len(m.exports), section)
+
+	if m.start != nil {
+		out = append(out, sectionStart)
+		start := appendU32(nil, m.startIdx)
+		out = appendU32(out, uint32(len(start)))
+		out = append(out, start...)
+	}
+
+	section = nil
+	for _, elem := range m.elems {
+		if elem.index == 0 {
+			section = append(section, 0x00)
+		} else {
+			// An active segment with an explicit table index, and function indices.
+			section = append(section, 0x02)
+			section = appendU32(section, elem.index)
+		}
+		section = append(section, elem.code...)
+		if elem.index != 0 {
+			section = append(section, 0x00)
+		}
+		section = appendU32(section, uint32(len(elem.funcs)))
+		for _, idx := range elem.funcs {
+			section = appendU32(section, idx)
+		}
+	}
+	out = appendSection(out, sectionElement, len(m.elems), section)
+
+	section = nil
+	for _, fn := range m.funcs {
+		body := appendLocals(nil, fn.locals)
+		body = append(body, fn.code...)
+
+		section = appendU32(section, uint32(len(body)))
+		section = append(section, body...)
+	}
+	out = appendSection(out, sectionCode, len(m.funcs), section)
+
+	section = nil
+	for _, data := range m.datas {
+		if data.index == 0 {
+			section = append(section, 0x00)
+		} else {
+			section = append(section, 0x02)
+			section = appendU32(section, data.index)
+		}
+		section = append(section, data.code...)
+		section = appendU32(section, uint32(len(data.data)))
+		section = append(section, data.data...)
+	}
+	out = appendSection(out, sectionData, len(m.datas), section)
+
+	return out
+}
+
+// appendSection appends a section holding count entries, unless it is empty.
+func appendSection(out []byte, id byte, count int, entries []byte) []byte {
+	if count == 0 {
+		return out
+	}
+
+	content := appendU32(nil, uint32(count))
+	content = append(content, entries...)
+
+	out = append(out, id)
+	out = appendU32(out, uint32(len(content)))
+	return append(out, content...)
+}
+
+// appendLocals appends the locals declarations, grouping the consecutive locals of the same type.
+func appendLocals(out []byte, locals []valType) []byte {
+	var groups []byte
+	count := 0
+
+	for i := 0; i < len(locals); {
+		j := i
+		for j < len(locals) && locals[j] == locals[i] {
+			j++
+		}
+
+		groups = appendU32(groups, uint32(j-i))
+		groups = append(groups, byte(locals[i]))
+		count++
+		i = j
+	}
+
+	out = appendU32(out, uint32(count))
+	return append(out, groups...)
+}
+
+func appendValTypes(out []byte, types []valType) []byte {
+	out = appendU32(out, uint32(len(types)))
+	for _, t := range types {
+		out = append(out, byte(t))
+	}
+	return out
+}
+
+func appendLimits(out []byte, l limits) []byte {
+	if !l.hasMax {
+		out = append(out, 0x00)
+		return appendU32(out, l.min)
+	}
+
+	out = append(out, 0x01)
+	out = appendU32(out, l.min)
+	return appendU32(out, l.max)
+}
+
+func appendGlobalType(out []byte, gt globalType) []byte {
+	out = append(out, byte(gt.typ))
+	if gt.mutable {
+		return append(out, 0x01)
+	}
+	return append(out, 0x00)
+}
+
+func appendName(out []byte, name string) []byte {
+	out = appendU32(out, uint32(len(name)))
+	return append(out, name...)
+}
+
+// appendU32 appends the unsigned LEB128 encoding of v.
+func appendU32(out []byte, v uint32) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+// appendS64 appends the signed LEB128 encoding of v.
+func appendS64(out []byte, v int64) []byte {
+	for {
+		b := byte(v & 0x7f)
+		v >>= 7
+		if v == 0 && b&0x40 == 0 || v == -1 && b&0x40 != 0 {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
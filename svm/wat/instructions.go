@@ -0,0 +1,524 @@
+package wat
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"strings"
+)
+
+// label is a block of the code, which branch instructions may target.
+type label struct {
+	pos  position
+	name string
+
+	// The block keyword (`block`, `loop` or `if`), or an empty string for the function body.
+	kind    string
+	hasElse bool
+}
+
+// codeCompiler compiles a sequence of instructions, either a function body
+// or a constant expression (in which case fn is nil).
+type codeCompiler struct {
+	m      *module
+	fn     *function
+	labels []label
+	code   []byte
+}
+
+// compileFunc resolves the function type and the locals names, and compiles its body.
+func (m *module) compileFunc(fn *function) error {
+	typeIdx, err := m.resolveTypeUse(fn.typeUse)
+	if err != nil {
+		return err
+	}
+	fn.typeIdx = typeIdx
+
+	params := m.types[typeIdx].params
+	fn.numLocals = uint32(len(params) + len(fn.locals))
+	fn.names = make(map[string]uint32)
+
+	names := append(append([]*node{}, fn.typeUse.paramNames...), fn.localNames...)
+	for i, name := range names {
+		if name == nil {
+			continue
+		}
+		if _, ok := fn.names[name.atom]; ok {
+			return errorf(name.pos, "duplicate local %v", name.atom)
+		}
+		if i >= len(fn.typeUse.paramNames) {
+			// The params may be declared via a type reference only, and have no names.
+			i += len(params) - len(fn.typeUse.paramNames)
+		}
+		fn.names[name.atom] = uint32(i)
+	}
+
+	c := &codeCompiler{m: m, fn: fn, labels: []label{{}}}
+	if err := c.instrs(fn.body); err != nil {
+		return err
+	}
+	if top := c.labels[len(c.labels)-1]; top.kind != "" {
+		return errorf(top.pos, "unclosed `%v`", top.kind)
+	}
+
+	fn.code = append(c.code, opEnd)
+	return nil
+}
+
+// compileConstExpr compiles a constant expression, e.g. a global initializer or a segment offset.
+func (m *module) compileConstExpr(nodes []*node) ([]byte, error) {
+	c := &codeCompiler{m: m}
+	if err := c.instrs(nodes); err != nil {
+		return nil, err
+	}
+	if len(c.labels) > 0 {
+		top := c.labels[len(c.labels)-1]
+		return nil, errorf(top.pos, "unclosed `%v`", top.kind)
+	}
+
+	return append(c.code, opEnd), nil
+}
+
+// instrs compiles a sequence of plain and folded instructions.
+func (c *codeCompiler) instrs(nodes []*node) error {
+	for i := 0; i < len(nodes); {
+		if nodes[i].isList {
+			if err := c.folded(nodes[i]); err != nil {
+				return err
+			}
+			i++
+			continue
+		}
+
+		n, err := c.plain(nodes, i)
+		if err != nil {
+			return err
+		}
+		i += n
+	}
+
+	return nil
+}
+
+func lookupInstruction(n *node) (instruction, error) {
+	if n.isSymbol() {
+		if instr, ok := instructions[n.atom]; ok {
+			return instr, nil
+		}
+	}
+
+	return instruction{}, errorf(n.pos, "unknown instruction `%v`", n)
+}
+
+// plain compiles the plain instruction at nodes[i], and returns the number of nodes it spans.
+func (c *codeCompiler) plain(nodes []*node, i int) (int, error) {
+	instr, err := lookupInstruction(nodes[i])
+	if err != nil {
+		return 0, err
+	}
+
+	j := i + 1
+	switch instr.imm {
+	case immBlock:
+		j, err = c.enterBlock(instr, nodes, i)
+	case immElse:
+		j, err = c.closingLabel(nodes, i)
+		if err == nil {
+			err = c.elseBranch(nodes[i])
+		}
+	case immEnd:
+		j, err = c.closingLabel(nodes, i)
+		if err == nil {
+			err = c.exitBlock(nodes[i])
+		}
+	default:
+		var code []byte
+		code, j, err = c.operator(instr, nodes, i)
+		c.code = append(c.code, code...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return j - i, nil
+}
+
+// folded compiles a folded instruction, whose operands are compiled ahead of it.
+func (c *codeCompiler) folded(n *node) error {
+	if len(n.list) == 0 {
+		return errorf(n.pos, "unexpected `()`")
+	}
+
+	instr, err := lookupInstruction(n.list[0])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case instr.imm == immBlock && n.isKeyword("if"):
+		return c.foldedIf(instr, n)
+	case instr.imm == immBlock:
+		j, err := c.enterBlock(instr, n.list, 0)
+		if err != nil {
+			return err
+		}
+		if err := c.instrs(n.list[j:]); err != nil {
+			return err
+		}
+		return c.exitBlock(n)
+	case instr.imm == immElse || instr.imm == immEnd:
+		return errorf(n.pos, "unexpected `%v`", n.list[0])
+	}
+
+	code, j, err := c.operator(instr, n.list, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, operand := range n.list[j:] {
+		if !operand.isList {
+			return errorf(operand.pos, "unexpected `%v`", operand)
+		}
+		if err := c.folded(operand); err != nil {
+			return err
+		}
+	}
+
+	c.code = append(c.code, code...)
+	return nil
+}
+
+// foldedIf compiles `(if label? blocktype condition* (then ...) (else ...)?)`.
+func (c *codeCompiler) foldedIf(instr instruction, n *node) error {
+	// The block is entered once the condition is compiled, so first skip its label and type.
+	cur := newCursor(n)
+	cur.id()
+	if _, err := parseTypeUse(cur); err != nil {
+		return err
+	}
+
+	for cur.peek() != nil && !cur.peekKeyword("then") {
+		cond := cur.next()
+		if !cond.isList {
+			return errorf(cond.pos, "unexpected `%v`", cond)
+		}
+		if err := c.folded(cond); err != nil {
+			return err
+		}
+	}
+
+	then := cur.next()
+	if then == nil {
+		return errorf(n.pos, "missing `then` branch")
+	}
+	if _, err := c.enterBlock(instr, n.list, 0); err != nil {
+		return err
+	}
+	if err := c.instrs(then.list[1:]); err != nil {
+		return err
+	}
+
+	if cur.peekKeyword("else") {
+		els := cur.next()
+		if err := c.elseBranch(els); err != nil {
+			return err
+		}
+		if err := c.instrs(els.list[1:]); err != nil {
+			return err
+		}
+	}
+	if err := cur.expectEnd(); err != nil {
+		return err
+	}
+
+	return c.exitBlock(n)
+}
+
+// enterBlock compiles the `block`, `loop` or `if` instruction at nodes[i],
+// along with its label and block type, and returns the index following them.
+func (c *codeCompiler) enterBlock(instr instruction, nodes []*node, i int) (int, error) {
+	cur := &cursor{items: nodes, i: i + 1, pos: nodes[i].pos}
+
+	l := label{pos: nodes[i].pos, kind: nodes[i].atom}
+	if id := cur.id(); id != nil {
+		l.name = id.atom
+	}
+
+	tu, err := parseTypeUse(cur)
+	if err != nil {
+		return 0, err
+	}
+
+	c.code = append(c.code, instr.opcode)
+	switch {
+	case tu.ref == nil && len(tu.params) == 0 && len(tu.results) == 0:
+		c.code = append(c.code, emptyBlock)
+	case tu.ref == nil && len(tu.params) == 0 && len(tu.results) == 1:
+		c.code = append(c.code, byte(tu.results[0]))
+	default:
+		// A multi-value block type, referring to a function type.
+		idx, err := c.m.resolveTypeUse(tu)
+		if err != nil {
+			return 0, err
+		}
+		c.code = appendS64(c.code, int64(idx))
+	}
+
+	c.labels = append(c.labels, l)
+	return cur.i, nil
+}
+
+// elseBranch compiles the `else` of the innermost block, which must be an `if`.
+func (c *codeCompiler) elseBranch(n *node) error {
+	if len(c.labels) == 0 {
+		return errorf(n.pos, "unexpected `else`")
+	}
+
+	top := &c.labels[len(c.labels)-1]
+	if top.kind != "if" || top.hasElse {
+		return errorf(n.pos, "unexpected `else`")
+	}
+
+	top.hasElse = true
+	c.code = append(c.code, opElse)
+	return nil
+}
+
+// exitBlock compiles the `end` of the innermost block.
+func (c *codeCompiler) exitBlock(n *node) error {
+	if len(c.labels) == 0 || c.labels[len(c.labels)-1].kind == "" {
+		return errorf(n.pos, "unexpected `end`")
+	}
+
+	c.labels = c.labels[:len(c.labels)-1]
+	c.code = append(c.code, opEnd)
+	return nil
+}
+
+// closingLabel consumes the optional label following the `else` or `end` at nodes[i],
+// which must match the label of the innermost block, and returns the index following it.
+func (c *codeCompiler) closingLabel(nodes []*node, i int) (int, error) {
+	if i+1 == len(nodes) || !nodes[i+1].isID() {
+		return i + 1, nil
+	}
+
+	id := nodes[i+1]
+	if len(c.labels) == 0 || c.labels[len(c.labels)-1].name != id.atom {
+		return 0, errorf(id.pos, "mismatching label %v", id.atom)
+	}
+
+	return i + 2, nil
+}
+
+// labelDepth resolves a branch target, either by depth or by label.
+func (c *codeCompiler) labelDepth(n *node) (uint32, error) {
+	if n.isID() {
+		for i := len(c.labels) - 1; i >= 0; i-- {
+			if c.labels[i].name == n.atom {
+				return uint32(len(c.labels) - 1 - i), nil
+			}
+		}
+		return 0, errorf(n.pos, "unknown label %v", n.atom)
+	}
+
+	depth, ok := parseNat(n.atom)
+	if !ok {
+		return 0, errorf(n.pos, "expected a label, given `%v`", n)
+	}
+	if depth >= uint32(len(c.labels)) {
+		return 0, errorf(n.pos, "label depth %v is out of range", depth)
+	}
+
+	return depth, nil
+}
+
+// resolveLocal resolves a local reference, either by index or by identifier.
+func (c *codeCompiler) resolveLocal(n *node) (uint32, error) {
+	if c.fn == nil {
+		return 0, errorf(n.pos, "unexpected local in a constant expression")
+	}
+
+	if n.isID() {
+		idx, ok := c.fn.names[n.atom]
+		if !ok {
+			return 0, errorf(n.pos, "unknown local %v", n.atom)
+		}
+		return idx, nil
+	}
+
+	idx, ok := parseNat(n.atom)
+	if !ok {
+		return 0, errorf(n.pos, "expected a local index, given `%v`", n)
+	}
+	if idx >= c.fn.numLocals {
+		return 0, errorf(n.pos, "local index %v is out of range", idx)
+	}
+
+	return idx, nil
+}
+
+// operator encodes the instruction at nodes[i] (other than the blocks ones) along with
+// its immediate arguments, and returns the index following them.
+func (c *codeCompiler) operator(instr instruction, nodes []*node, i int) ([]byte, int, error) {
+	code := []byte{instr.opcode}
+	j := i + 1
+
+	arg := func() (*node, error) {
+		if j == len(nodes) || !nodes[j].isSymbol() {
+			return nil, errorf(nodes[i].pos, "missing argument of `%v`", nodes[i])
+		}
+		j++
+		return nodes[j-1], nil
+	}
+
+	switch instr.imm {
+	case immLabel:
+		n, err := arg()
+		if err != nil {
+			return nil, 0, err
+		}
+		depth, err := c.labelDepth(n)
+		if err != nil {
+			return nil, 0, err
+		}
+		code = appendU32(code, depth)
+	case immLabelTable:
+		var depths []uint32
+		for j < len(nodes) && isIndex(nodes[j]) {
+			depth, err := c.labelDepth(nodes[j])
+			if err != nil {
+				return nil, 0, err
+			}
+			depths = append(depths, depth)
+			j++
+		}
+		if len(depths) == 0 {
+			return nil, 0, errorf(nodes[i].pos, "missing argument of `%v`", nodes[i])
+		}
+
+		// The last label is the default target.
+		code = appendU32(code, uint32(len(depths)-1))
+		for _, depth := range depths {
+			code = appendU32(code, depth)
+		}
+	case immFunc:
+		n, err := arg()
+		if err != nil {
+			return nil, 0, err
+		}
+		idx, err := c.m.funcSpace.resolve(n)
+		if err != nil {
+			return nil, 0, err
+		}
+		code = appendU32(code, idx)
+	case immCallIndirect:
+		var tableIdx uint32
+		if j < len(nodes) && isIndex(nodes[j]) {
+			idx, err := c.m.tableSpace.resolve(nodes[j])
+			if err != nil {
+				return nil, 0, err
+			}
+			tableIdx = idx
+			j++
+		}
+
+		cur := &cursor{items: nodes, i: j, pos: nodes[i].pos}
+		tu, err := parseTypeUse(cur)
+		if err != nil {
+			return nil, 0, err
+		}
+		j = cur.i
+
+		typeIdx, err := c.m.resolveTypeUse(tu)
+		if err != nil {
+			return nil, 0, err
+		}
+		code = appendU32(code, typeIdx)
+		code = appendU32(code, tableIdx)
+	case immLocal:
+		n, err := arg()
+		if err != nil {
+			return nil, 0, err
+		}
+		idx, err := c.resolveLocal(n)
+		if err != nil {
+			return nil, 0, err
+		}
+		code = appendU32(code, idx)
+	case immGlobal:
+		n, err := arg()
+		if err != nil {
+			return nil, 0, err
+		}
+		idx, err := c.m.globalSpace.resolve(n)
+		if err != nil {
+			return nil, 0, err
+		}
+		code = appendU32(code, idx)
+	case immMemArg:
+		var offset uint32
+		align := instr.align
+
+		if j < len(nodes) && nodes[j].isSymbol() && strings.HasPrefix(nodes[j].atom, "offset=") {
+			v, ok := parseNat(strings.TrimPrefix(nodes[j].atom, "offset="))
+			if !ok {
+				return nil, 0, errorf(nodes[j].pos, "invalid memory offset `%v`", nodes[j])
+			}
+			offset = v
+			j++
+		}
+		if j < len(nodes) && nodes[j].isSymbol() && strings.HasPrefix(nodes[j].atom, "align=") {
+			v, ok := parseNat(strings.TrimPrefix(nodes[j].atom, "align="))
+			if !ok || v == 0 || v&(v-1) != 0 {
+				return nil, 0, errorf(nodes[j].pos, "invalid memory alignment `%v`", nodes[j])
+			}
+			if align = uint32(bits.TrailingZeros32(v)); align > instr.align {
+				return nil, 0, errorf(nodes[j].pos, "alignment must not be larger than natural")
+			}
+			j++
+		}
+
+		code = appendU32(code, align)
+		code = appendU32(code, offset)
+	case immMemory:
+		// The reserved memory index.
+		code = append(code, 0x00)
+	case immI32, immI64:
+		n, err := arg()
+		if err != nil {
+			return nil, 0, err
+		}
+		bitSize := uint(32)
+		if instr.imm == immI64 {
+			bitSize = 64
+		}
+		v, ok := parseInt(n.atom, bitSize)
+		if !ok {
+			return nil, 0, errorf(n.pos, "invalid i%v literal `%v`", bitSize, n)
+		}
+		code = appendS64(code, v)
+	case immF32:
+		n, err := arg()
+		if err != nil {
+			return nil, 0, err
+		}
+		v, ok := parseFloat(n.atom, 32)
+		if !ok {
+			return nil, 0, errorf(n.pos, "invalid f32 literal `%v`", n)
+		}
+		code = append(code, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(code[len(code)-4:], uint32(v))
+	case immF64:
+		n, err := arg()
+		if err != nil {
+			return nil, 0, err
+		}
+		v, ok := parseFloat(n.atom, 64)
+		if !ok {
+			return nil, 0, errorf(n.pos, "invalid f64 literal `%v`", n)
+		}
+		code = append(code, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.LittleEndian.PutUint64(code[len(code)-8:], v)
+	}
+
+	return code, j, nil
+}
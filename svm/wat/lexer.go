@@ -0,0 +1,307 @@
+package wat
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// position is a line:column location in the source, both 1-based.
+type position struct {
+	line int
+	col  int
+}
+
+// String helps position to implement the Stringer interface.
+func (p position) String() string {
+	return fmt.Sprintf("%v:%v", p.line, p.col)
+}
+
+// errorf creates an error located at pos.
+func errorf(pos position, format string, a ...interface{}) error {
+	return fmt.Errorf("%v: %v", pos, fmt.Sprintf(format, a...))
+}
+
+// node is an s-expression: either a parenthesized list of nodes,
+// or an atom (a keyword, an identifier, a number or a string literal).
+type node struct {
+	pos position
+
+	isList bool
+	list   []*node
+
+	atom     string
+	isString bool
+}
+
+// String helps node to implement the Stringer interface.
+func (n *node) String() string {
+	switch {
+	case n.isList:
+		s := make([]string, len(n.list))
+		for i, child := range n.list {
+			s[i] = child.String()
+		}
+		return "(" + strings.Join(s, " ") + ")"
+	case n.isString:
+		return strconv.Quote(n.atom)
+	default:
+		return n.atom
+	}
+}
+
+// keyword returns the keyword opening a list node (e.g. "func" for `(func ...)`),
+// or an empty string if n isn't such a list.
+func (n *node) keyword() string {
+	if !n.isList || len(n.list) == 0 {
+		return ""
+	}
+
+	head := n.list[0]
+	if head.isList || head.isString {
+		return ""
+	}
+
+	return head.atom
+}
+
+// isKeyword reports whether n is a list opened by keyword kw.
+func (n *node) isKeyword(kw string) bool {
+	return n.keyword() == kw
+}
+
+// isID reports whether n is an identifier atom (e.g. `$counter`).
+func (n *node) isID() bool {
+	return !n.isList && !n.isString && strings.HasPrefix(n.atom, "$")
+}
+
+// isSymbol reports whether n is a non-string atom.
+func (n *node) isSymbol() bool {
+	return !n.isList && !n.isString
+}
+
+type lexer struct {
+	src  []byte
+	off  int
+	line int
+	col  int
+}
+
+// parse reads the source into its top-level s-expressions.
+func parse(src []byte) ([]*node, error) {
+	l := &lexer{src: src, line: 1, col: 1}
+
+	var stack [][]*node
+	var opens []position
+	var top []*node
+
+	for {
+		if err := l.skipSpace(); err != nil {
+			return nil, err
+		}
+		if l.off == len(l.src) {
+			break
+		}
+
+		pos := l.pos()
+		switch c := l.src[l.off]; c {
+		case '(':
+			l.advance(1)
+			stack = append(stack, top)
+			opens = append(opens, pos)
+			top = nil
+		case ')':
+			if len(stack) == 0 {
+				return nil, errorf(pos, "unexpected `)`")
+			}
+			l.advance(1)
+			list := &node{pos: opens[len(opens)-1], isList: true, list: top}
+			top = append(stack[len(stack)-1], list)
+			stack = stack[:len(stack)-1]
+			opens = opens[:len(opens)-1]
+		case '"':
+			s, err := l.readString()
+			if err != nil {
+				return nil, err
+			}
+			top = append(top, &node{pos: pos, atom: s, isString: true})
+		default:
+			s := l.readAtom()
+			if s == "" {
+				return nil, errorf(pos, "unexpected character %q", c)
+			}
+			top = append(top, &node{pos: pos, atom: s})
+		}
+	}
+
+	if len(opens) > 0 {
+		return nil, errorf(opens[len(opens)-1], "unclosed `(`")
+	}
+
+	return top, nil
+}
+
+func (l *lexer) pos() position {
+	return position{l.line, l.col}
+}
+
+// advance moves n bytes forward, none of which is a new line.
+func (l *lexer) advance(n int) {
+	l.off += n
+	l.col += n
+}
+
+// skipSpace skips the white space, the line comments (`;; ...`)
+// and the possibly nested block comments (`(; ... ;)`).
+func (l *lexer) skipSpace() error {
+	for l.off < len(l.src) {
+		switch {
+		case l.src[l.off] == '\n':
+			l.off++
+			l.line++
+			l.col = 1
+		case l.src[l.off] == ' ' || l.src[l.off] == '\t' || l.src[l.off] == '\r':
+			l.advance(1)
+		case l.hasPrefix(";;"):
+			for l.off < len(l.src) && l.src[l.off] != '\n' {
+				l.advance(1)
+			}
+		case l.hasPrefix("(;"):
+			if err := l.skipBlockComment(); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (l *lexer) skipBlockComment() error {
+	pos := l.pos()
+	depth := 0
+
+	for l.off < len(l.src) {
+		switch {
+		case l.hasPrefix("(;"):
+			l.advance(2)
+			depth++
+		case l.hasPrefix(";)"):
+			l.advance(2)
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		case l.src[l.off] == '\n':
+			l.off++
+			l.line++
+			l.col = 1
+		default:
+			l.advance(1)
+		}
+	}
+
+	return errorf(pos, "unclosed block comment")
+}
+
+func (l *lexer) hasPrefix(s string) bool {
+	return bytes.HasPrefix(l.src[l.off:], []byte(s))
+}
+
+// isIDChar reports whether c may be part of a keyword, an identifier or a number.
+func isIDChar(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+
+	return strings.IndexByte("!#$%&'*+-./:<=>?@\\^_`|~", c) >= 0
+}
+
+func (l *lexer) readAtom() string {
+	start := l.off
+	for l.off < len(l.src) && isIDChar(l.src[l.off]) {
+		l.advance(1)
+	}
+
+	return string(l.src[start:l.off])
+}
+
+// readString reads a string literal, decoding its escape sequences.
+// Its value is a sequence of bytes, which isn't necessarily valid UTF-8.
+func (l *lexer) readString() (string, error) {
+	pos := l.pos()
+	l.advance(1)
+
+	var b strings.Builder
+	for {
+		if l.off == len(l.src) || l.src[l.off] == '\n' {
+			return "", errorf(pos, "unterminated string")
+		}
+
+		c := l.src[l.off]
+		switch {
+		case c == '"':
+			l.advance(1)
+			return b.String(), nil
+		case c == '\\':
+			if err := l.readEscape(&b); err != nil {
+				return "", err
+			}
+		case c < 0x20 || c == 0x7f:
+			return "", errorf(l.pos(), "invalid character %q in string", c)
+		default:
+			_, size := utf8.DecodeRune(l.src[l.off:])
+			b.Write(l.src[l.off : l.off+size])
+			l.advance(size)
+		}
+	}
+}
+
+func (l *lexer) readEscape(b *strings.Builder) error {
+	pos := l.pos()
+	if l.off+1 >= len(l.src) {
+		return errorf(pos, "unterminated string")
+	}
+
+	switch c := l.src[l.off+1]; c {
+	case 'n':
+		b.WriteByte('\n')
+	case 't':
+		b.WriteByte('\t')
+	case 'r':
+		b.WriteByte('\r')
+	case '"', '\'', '\\':
+		b.WriteByte(c)
+	case 'u':
+		end := strings.IndexByte(string(l.src[l.off:]), '}')
+		if l.off+2 >= len(l.src) || l.src[l.off+2] != '{' || end < 0 {
+			return errorf(pos, "invalid unicode escape")
+		}
+		hex := string(l.src[l.off+3 : l.off+end])
+		r, err := strconv.ParseUint(strings.Replace(hex, "_", "", -1), 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return errorf(pos, "invalid unicode escape")
+		}
+		b.WriteRune(rune(r))
+		l.advance(end + 1)
+		return nil
+	default:
+		if l.off+2 >= len(l.src) {
+			return errorf(pos, "unterminated string")
+		}
+		v, err := strconv.ParseUint(string(l.src[l.off+1:l.off+3]), 16, 8)
+		if err != nil {
+			return errorf(pos, "invalid escape sequence `\\%c`", c)
+		}
+		b.WriteByte(byte(v))
+		l.advance(3)
+		return nil
+	}
+
+	l.advance(2)
+	return nil
+}
@@ -0,0 +1,1107 @@
+package wat
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Compile compiles a module written in the WebAssembly text format into its binary format.
+// The source holds either a single `(module ...)`, or the module fields themselves.
+func Compile(src []byte) ([]byte, error) {
+	nodes, err := parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile wat: %w", err)
+	}
+
+	fields, err := moduleFields(nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile wat: %w", err)
+	}
+
+	m := newModule()
+	if err := m.declare(fields); err != nil {
+		return nil, fmt.Errorf("failed to compile wat: %w", err)
+	}
+	if err := m.resolve(); err != nil {
+		return nil, fmt.Errorf("failed to compile wat: %w", err)
+	}
+
+	return m.encode(), nil
+}
+
+func moduleFields(nodes []*node) ([]*node, error) {
+	if len(nodes) == 0 || !nodes[0].isKeyword("module") {
+		return nodes, nil
+	}
+
+	if len(nodes) > 1 {
+		return nil, errorf(nodes[1].pos, "unexpected `%v` after the module", nodes[1].keyword())
+	}
+
+	fields := nodes[0].list[1:]
+	if len(fields) > 0 && fields[0].isID() {
+		fields = fields[1:]
+	}
+
+	return fields, nil
+}
+
+// The external kinds, shared by the imports and the exports.
+const (
+	kindFunc   byte = 0x00
+	kindTable  byte = 0x01
+	kindMemory byte = 0x02
+	kindGlobal byte = 0x03
+)
+
+var externalKinds = map[string]byte{
+	"func":   kindFunc,
+	"table":  kindTable,
+	"memory": kindMemory,
+	"global": kindGlobal,
+}
+
+// isExternalKind reports whether n is a list opened by an external kind keyword (e.g. `(func ...)`).
+func isExternalKind(n *node) bool {
+	_, ok := externalKinds[n.keyword()]
+	return ok
+}
+
+type funcType struct {
+	params  []valType
+	results []valType
+}
+
+func (t funcType) equal(other funcType) bool {
+	return equalValTypes(t.params, other.params) && equalValTypes(t.results, other.results)
+}
+
+func equalValTypes(a, b []valType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// typeUse is a reference to a function type, either by its index (`(type $t)`),
+// or by its inline params and results, or both.
+type typeUse struct {
+	pos position
+	ref *node
+
+	params     []valType
+	paramNames []*node
+	results    []valType
+}
+
+type limits struct {
+	min    uint32
+	max    uint32
+	hasMax bool
+}
+
+type globalType struct {
+	typ     valType
+	mutable bool
+}
+
+type importEntry struct {
+	module string
+	name   string
+	kind   byte
+
+	typeIdx uint32
+	limits  limits
+	global  globalType
+}
+
+type function struct {
+	typeUse typeUse
+	typeIdx uint32
+
+	locals     []valType
+	localNames []*node
+	body       []*node
+
+	// Resolved once the function type is known.
+	numLocals uint32
+	names     map[string]uint32
+
+	code []byte
+}
+
+type global struct {
+	globalType
+	init []*node
+	code []byte
+}
+
+type export struct {
+	name  string
+	kind  byte
+	index uint32
+
+	// The reference of an export field, resolved once all the definitions are known.
+	ref *node
+}
+
+// segment is an element segment (funcs) or a data segment (data) with an active offset.
+type segment struct {
+	index  uint32
+	offset []*node
+	code   []byte
+
+	funcRefs []*node
+	funcs    []uint32
+
+	data []byte
+}
+
+// indexSpace assigns the indices of the definitions of a kind, and resolves their references.
+type indexSpace struct {
+	kind  string
+	names map[string]uint32
+	count uint32
+}
+
+func newIndexSpace(kind string) *indexSpace {
+	return &indexSpace{kind: kind, names: make(map[string]uint32)}
+}
+
+// add assigns the next index to a definition, with an optional identifier.
+func (s *indexSpace) add(id *node) (uint32, error) {
+	idx := s.count
+	if id != nil {
+		if _, ok := s.names[id.atom]; ok {
+			return 0, errorf(id.pos, "duplicate %v %v", s.kind, id.atom)
+		}
+		s.names[id.atom] = idx
+	}
+
+	s.count++
+	return idx, nil
+}
+
+// resolve resolves a reference, either by index or by identifier.
+func (s *indexSpace) resolve(n *node) (uint32, error) {
+	if !n.isSymbol() {
+		return 0, errorf(n.pos, "expected a %v index, given `%v`", s.kind, n)
+	}
+
+	if n.isID() {
+		idx, ok := s.names[n.atom]
+		if !ok {
+			return 0, errorf(n.pos, "unknown %v %v", s.kind, n.atom)
+		}
+		return idx, nil
+	}
+
+	idx, ok := parseNat(n.atom)
+	if !ok {
+		return 0, errorf(n.pos, "expected a %v index, given `%v`", s.kind, n)
+	}
+	if idx >= s.count {
+		return 0, errorf(n.pos, "%v index %v is out of range", s.kind, idx)
+	}
+
+	return idx, nil
+}
+
+// isIndex reports whether n is a reference, either by index or by identifier.
+func isIndex(n *node) bool {
+	if n.isID() {
+		return true
+	}
+
+	_, ok := parseNat(n.atom)
+	return n.isSymbol() && ok
+}
+
+type module struct {
+	types []funcType
+
+	typeSpace   *indexSpace
+	funcSpace   *indexSpace
+	tableSpace  *indexSpace
+	memorySpace *indexSpace
+	globalSpace *indexSpace
+
+	imports []*importEntry
+
+	// Whether a function, table, memory or global was defined, after which no import may occur.
+	defined bool
+
+	funcs    []*function
+	tables   []limits
+	memories []limits
+	globals  []*global
+	exports  []*export
+	start    *node
+	startIdx uint32
+	elems    []*segment
+	datas    []*segment
+
+	// The function types references, along with the function bodies, are resolved
+	// in the order of the source, since they append the implicitly defined types.
+	pending []func() error
+}
+
+func newModule() *module {
+	return &module{
+		typeSpace:   newIndexSpace("type"),
+		funcSpace:   newIndexSpace("function"),
+		tableSpace:  newIndexSpace("table"),
+		memorySpace: newIndexSpace("memory"),
+		globalSpace: newIndexSpace("global"),
+	}
+}
+
+// cursor iterates over the items of a list node.
+type cursor struct {
+	items []*node
+	i     int
+
+	// The position reported when the items are exhausted.
+	pos position
+}
+
+// newCursor iterates over the items of list n, following its keyword.
+func newCursor(n *node) *cursor {
+	return &cursor{items: n.list, i: 1, pos: n.pos}
+}
+
+func (c *cursor) peek() *node {
+	if c.i == len(c.items) {
+		return nil
+	}
+	return c.items[c.i]
+}
+
+func (c *cursor) next() *node {
+	n := c.peek()
+	if n != nil {
+		c.i++
+	}
+	return n
+}
+
+func (c *cursor) rest() []*node {
+	items := c.items[c.i:]
+	c.i = len(c.items)
+	return items
+}
+
+// id consumes an optional identifier.
+func (c *cursor) id() *node {
+	if n := c.peek(); n != nil && n.isID() {
+		return c.next()
+	}
+	return nil
+}
+
+// peekKeyword reports whether the next item is a list opened by keyword kw.
+func (c *cursor) peekKeyword(kw string) bool {
+	n := c.peek()
+	return n != nil && n.isKeyword(kw)
+}
+
+// expectString consumes a string literal.
+func (c *cursor) expectString(what string) (*node, error) {
+	n := c.next()
+	if n == nil || !n.isString {
+		return nil, c.expected(n, what)
+	}
+	return n, nil
+}
+
+// expectName consumes a string literal used as an import or export name.
+func (c *cursor) expectName(what string) (string, error) {
+	n, err := c.expectString(what)
+	if err != nil {
+		return "", err
+	}
+	if !utf8.ValidString(n.atom) {
+		return "", errorf(n.pos, "malformed UTF-8 %v", what)
+	}
+	return n.atom, nil
+}
+
+// expectEnd checks that the items are exhausted.
+func (c *cursor) expectEnd() error {
+	if n := c.peek(); n != nil {
+		return errorf(n.pos, "unexpected `%v`", n)
+	}
+	return nil
+}
+
+// expected returns an error for n (or for the missing item, if nil) not being what was expected.
+func (c *cursor) expected(n *node, what string) error {
+	if n == nil {
+		return errorf(c.pos, "missing %v", what)
+	}
+	return errorf(n.pos, "expected %v, given `%v`", what, n)
+}
+
+// inlineExports consumes the `(export "name")` abbreviations of a definition.
+func (c *cursor) inlineExports() ([]string, error) {
+	var names []string
+	for c.peekKeyword("export") {
+		ec := newCursor(c.next())
+		name, err := ec.expectName("export name")
+		if err != nil {
+			return nil, err
+		}
+		if err := ec.expectEnd(); err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// inlineImport consumes the `(import "module" "name")` abbreviation of a definition, if any.
+func (c *cursor) inlineImport() (*importEntry, position, bool, error) {
+	if !c.peekKeyword("import") {
+		return nil, position{}, false, nil
+	}
+
+	n := c.next()
+	ic := newCursor(n)
+	module, err := ic.expectName("import module name")
+	if err != nil {
+		return nil, n.pos, false, err
+	}
+	name, err := ic.expectName("import name")
+	if err != nil {
+		return nil, n.pos, false, err
+	}
+	if err := ic.expectEnd(); err != nil {
+		return nil, n.pos, false, err
+	}
+
+	return &importEntry{module: module, name: name}, n.pos, true, nil
+}
+
+func (m *module) declare(fields []*node) error {
+	for _, n := range fields {
+		var err error
+		switch n.keyword() {
+		case "type":
+			err = m.declareType(n)
+		case "import":
+			err = m.declareImport(n)
+		case "func":
+			err = m.declareFunc(n)
+		case "table":
+			err = m.declareTable(n)
+		case "memory":
+			err = m.declareMemory(n)
+		case "global":
+			err = m.declareGlobal(n)
+		case "export":
+			err = m.declareExport(n)
+		case "start":
+			err = m.declareStart(n)
+		case "elem":
+			err = m.declareElem(n)
+		case "data":
+			err = m.declareData(n)
+		default:
+			err = errorf(n.pos, "unknown module field `%v`", n)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *module) addExports(names []string, kind byte, idx uint32) {
+	for _, name := range names {
+		m.exports = append(m.exports, &export{name: name, kind: kind, index: idx})
+	}
+}
+
+// addImport adds an import, which must precede all the definitions.
+func (m *module) addImport(imp *importEntry, pos position) error {
+	if m.defined {
+		return errorf(pos, "imports must occur before all non-import definitions")
+	}
+
+	m.imports = append(m.imports, imp)
+	return nil
+}
+
+func (m *module) declareType(n *node) error {
+	c := newCursor(n)
+	id := c.id()
+
+	fn := c.next()
+	if fn == nil || !fn.isKeyword("func") {
+		return c.expected(fn, "function type")
+	}
+	if err := c.expectEnd(); err != nil {
+		return err
+	}
+
+	fc := newCursor(fn)
+	tu, err := parseTypeUse(fc)
+	if err != nil {
+		return err
+	}
+	if tu.ref != nil {
+		return errorf(tu.ref.pos, "unexpected type reference in a function type")
+	}
+	if err := fc.expectEnd(); err != nil {
+		return err
+	}
+
+	if _, err := m.typeSpace.add(id); err != nil {
+		return err
+	}
+	m.types = append(m.types, funcType{params: tu.params, results: tu.results})
+	return nil
+}
+
+func (m *module) declareImport(n *node) error {
+	c := newCursor(n)
+	module, err := c.expectName("import module name")
+	if err != nil {
+		return err
+	}
+	name, err := c.expectName("import name")
+	if err != nil {
+		return err
+	}
+
+	desc := c.next()
+	if desc == nil || !isExternalKind(desc) {
+		return c.expected(desc, "import description")
+	}
+	if err := c.expectEnd(); err != nil {
+		return err
+	}
+
+	dc := newCursor(desc)
+	id := dc.id()
+	imp := &importEntry{module: module, name: name}
+	if err := m.declareImportDesc(desc.keyword(), id, imp, dc, n.pos); err != nil {
+		return err
+	}
+
+	return dc.expectEnd()
+}
+
+// declareImportDesc declares an import of the given kind, described by the items of c.
+func (m *module) declareImportDesc(kind string, id *node, imp *importEntry, c *cursor, pos position) error {
+	if err := m.addImport(imp, pos); err != nil {
+		return err
+	}
+
+	imp.kind = externalKinds[kind]
+	switch imp.kind {
+	case kindFunc:
+		tu, err := parseTypeUse(c)
+		if err != nil {
+			return err
+		}
+		m.pending = append(m.pending, func() (err error) {
+			imp.typeIdx, err = m.resolveTypeUse(tu)
+			return
+		})
+		_, err = m.funcSpace.add(id)
+		return err
+	case kindTable:
+		l, err := parseTableType(c)
+		if err != nil {
+			return err
+		}
+		imp.limits = l
+		_, err = m.tableSpace.add(id)
+		return err
+	case kindMemory:
+		l, err := parseLimits(c)
+		if err != nil {
+			return err
+		}
+		imp.limits = l
+		_, err = m.memorySpace.add(id)
+		return err
+	default:
+		gt, err := parseGlobalType(c)
+		if err != nil {
+			return err
+		}
+		imp.global = gt
+		_, err = m.globalSpace.add(id)
+		return err
+	}
+}
+
+func (m *module) declareFunc(n *node) error {
+	c := newCursor(n)
+	id := c.id()
+	exports, err := c.inlineExports()
+	if err != nil {
+		return err
+	}
+
+	if imp, pos, ok, err := c.inlineImport(); err != nil {
+		return err
+	} else if ok {
+		if err := m.declareImportDesc("func", id, imp, c, pos); err != nil {
+			return err
+		}
+		m.addExports(exports, kindFunc, m.funcSpace.count-1)
+		return c.expectEnd()
+	}
+
+	tu, err := parseTypeUse(c)
+	if err != nil {
+		return err
+	}
+
+	fn := &function{typeUse: tu}
+	for c.peekKeyword("local") {
+		locals, names, err := parseValTypes(c.next(), true)
+		if err != nil {
+			return err
+		}
+		fn.locals = append(fn.locals, locals...)
+		fn.localNames = append(fn.localNames, names...)
+	}
+	fn.body = c.rest()
+
+	idx, err := m.funcSpace.add(id)
+	if err != nil {
+		return err
+	}
+	m.defined = true
+	m.funcs = append(m.funcs, fn)
+	m.addExports(exports, kindFunc, idx)
+
+	m.pending = append(m.pending, func() error {
+		return m.compileFunc(fn)
+	})
+	return nil
+}
+
+func (m *module) declareTable(n *node) error {
+	c := newCursor(n)
+	id := c.id()
+	exports, err := c.inlineExports()
+	if err != nil {
+		return err
+	}
+
+	if imp, pos, ok, err := c.inlineImport(); err != nil {
+		return err
+	} else if ok {
+		if err := m.declareImportDesc("table", id, imp, c, pos); err != nil {
+			return err
+		}
+		m.addExports(exports, kindTable, m.tableSpace.count-1)
+		return c.expectEnd()
+	}
+
+	var l limits
+	var elem *segment
+	if next := c.peek(); next != nil && isElemType(next) {
+		// The `(table funcref (elem $f ...))` abbreviation,
+		// for a table holding exactly the given functions.
+		c.next()
+		elems := c.next()
+		if elems == nil || !elems.isKeyword("elem") {
+			return c.expected(elems, "table elements")
+		}
+		elem = &segment{code: []byte{0x41, 0x00, opEnd}, funcRefs: elems.list[1:]}
+		l = limits{min: uint32(len(elem.funcRefs)), max: uint32(len(elem.funcRefs)), hasMax: true}
+	} else if l, err = parseTableType(c); err != nil {
+		return err
+	}
+	if err := c.expectEnd(); err != nil {
+		return err
+	}
+
+	idx, err := m.tableSpace.add(id)
+	if err != nil {
+		return err
+	}
+	m.defined = true
+	m.tables = append(m.tables, l)
+	m.addExports(exports, kindTable, idx)
+
+	if elem != nil {
+		elem.index = idx
+		m.elems = append(m.elems, elem)
+	}
+	return nil
+}
+
+func (m *module) declareMemory(n *node) error {
+	c := newCursor(n)
+	id := c.id()
+	exports, err := c.inlineExports()
+	if err != nil {
+		return err
+	}
+
+	if imp, pos, ok, err := c.inlineImport(); err != nil {
+		return err
+	} else if ok {
+		if err := m.declareImportDesc("memory", id, imp, c, pos); err != nil {
+			return err
+		}
+		m.addExports(exports, kindMemory, m.memorySpace.count-1)
+		return c.expectEnd()
+	}
+
+	var l limits
+	var data *segment
+	if c.peekKeyword("data") {
+		// The `(memory (data "..."))` abbreviation,
+		// for a memory holding exactly the given data.
+		data = &segment{code: []byte{0x41, 0x00, opEnd}}
+		dc := newCursor(c.next())
+		for dc.peek() != nil {
+			s, err := dc.expectString("data string")
+			if err != nil {
+				return err
+			}
+			data.data = append(data.data, s.atom...)
+		}
+		pages := uint32((len(data.data) + pageSize - 1) / pageSize)
+		l = limits{min: pages, max: pages, hasMax: true}
+	} else if l, err = parseLimits(c); err != nil {
+		return err
+	}
+	if err := c.expectEnd(); err != nil {
+		return err
+	}
+
+	idx, err := m.memorySpace.add(id)
+	if err != nil {
+		return err
+	}
+	m.defined = true
+	m.memories = append(m.memories, l)
+	m.addExports(exports, kindMemory, idx)
+
+	if data != nil {
+		data.index = idx
+		m.datas = append(m.datas, data)
+	}
+	return nil
+}
+
+// pageSize is the size of a WebAssembly memory page.
+const pageSize = 64 * 1024
+
+func (m *module) declareGlobal(n *node) error {
+	c := newCursor(n)
+	id := c.id()
+	exports, err := c.inlineExports()
+	if err != nil {
+		return err
+	}
+
+	if imp, pos, ok, err := c.inlineImport(); err != nil {
+		return err
+	} else if ok {
+		if err := m.declareImportDesc("global", id, imp, c, pos); err != nil {
+			return err
+		}
+		m.addExports(exports, kindGlobal, m.globalSpace.count-1)
+		return c.expectEnd()
+	}
+
+	gt, err := parseGlobalType(c)
+	if err != nil {
+		return err
+	}
+
+	idx, err := m.globalSpace.add(id)
+	if err != nil {
+		return err
+	}
+	m.defined = true
+	m.globals = append(m.globals, &global{globalType: gt, init: c.rest()})
+	m.addExports(exports, kindGlobal, idx)
+	return nil
+}
+
+func (m *module) declareExport(n *node) error {
+	c := newCursor(n)
+	name, err := c.expectName("export name")
+	if err != nil {
+		return err
+	}
+
+	desc := c.next()
+	if desc == nil || !isExternalKind(desc) {
+		return c.expected(desc, "export description")
+	}
+	if err := c.expectEnd(); err != nil {
+		return err
+	}
+
+	dc := newCursor(desc)
+	ref := dc.next()
+	if ref == nil {
+		return dc.expected(ref, desc.keyword()+" index")
+	}
+	if err := dc.expectEnd(); err != nil {
+		return err
+	}
+
+	m.exports = append(m.exports, &export{name: name, kind: externalKinds[desc.keyword()], ref: ref})
+	return nil
+}
+
+func (m *module) declareStart(n *node) error {
+	if m.start != nil {
+		return errorf(n.pos, "multiple start functions")
+	}
+
+	c := newCursor(n)
+	ref := c.next()
+	if ref == nil {
+		return c.expected(ref, "function index")
+	}
+	m.start = ref
+	return c.expectEnd()
+}
+
+// parseSegment parses the target and the offset of an element or a data segment,
+// given the keyword (`table` or `memory`) of its target.
+func parseSegment(c *cursor, target string) (*node, []*node, error) {
+	// The segment identifier is only used by the instructions of post-MVP proposals.
+	c.id()
+
+	var ref *node
+	switch next := c.peek(); {
+	case next != nil && next.isSymbol() && isIndex(next):
+		// The MVP syntax, e.g. `(data 0 (i32.const 0) "...")`.
+		ref = c.next()
+	case c.peekKeyword(target):
+		tc := newCursor(c.next())
+		if ref = tc.next(); ref == nil {
+			return nil, nil, tc.expected(ref, target+" index")
+		}
+		if err := tc.expectEnd(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	offset := c.next()
+	if offset == nil || !offset.isList || offset.isKeyword("elem") {
+		return nil, nil, errorf(c.pos, "passive and declarative segments are not supported")
+	}
+	if offset.isKeyword("offset") {
+		return ref, offset.list[1:], nil
+	}
+
+	return ref, []*node{offset}, nil
+}
+
+func (m *module) declareElem(n *node) error {
+	c := newCursor(n)
+	ref, offset, err := parseSegment(c, "table")
+	if err != nil {
+		return err
+	}
+
+	if next := c.peek(); next != nil && next.isSymbol() && next.atom == "func" {
+		c.next()
+	}
+
+	elem := &segment{offset: offset, funcRefs: c.rest()}
+	m.elems = append(m.elems, elem)
+	m.pending = append(m.pending, func() (err error) {
+		if ref != nil {
+			elem.index, err = m.tableSpace.resolve(ref)
+		}
+		return
+	})
+	return nil
+}
+
+func (m *module) declareData(n *node) error {
+	c := newCursor(n)
+	ref, offset, err := parseSegment(c, "memory")
+	if err != nil {
+		return err
+	}
+
+	data := &segment{offset: offset}
+	for c.peek() != nil {
+		s, err := c.expectString("data string")
+		if err != nil {
+			return err
+		}
+		data.data = append(data.data, s.atom...)
+	}
+
+	m.datas = append(m.datas, data)
+	m.pending = append(m.pending, func() (err error) {
+		if ref != nil {
+			data.index, err = m.memorySpace.resolve(ref)
+		}
+		return
+	})
+	return nil
+}
+
+func parseValType(n *node) (valType, error) {
+	if n.isSymbol() {
+		if t, ok := valTypes[n.atom]; ok {
+			return t, nil
+		}
+	}
+
+	return 0, errorf(n.pos, "unknown value type `%v`", n)
+}
+
+// parseValTypes parses a `(param ...)`, `(result ...)` or `(local ...)` list, which is either
+// a single named value type (if named is allowed), or any number of anonymous value types.
+func parseValTypes(n *node, named bool) ([]valType, []*node, error) {
+	items := n.list[1:]
+	if named && len(items) > 0 && items[0].isID() {
+		if len(items) != 2 {
+			return nil, nil, errorf(n.pos, "expected a single value type for %v", items[0].atom)
+		}
+		t, err := parseValType(items[1])
+		if err != nil {
+			return nil, nil, err
+		}
+		return []valType{t}, []*node{items[0]}, nil
+	}
+
+	types := make([]valType, len(items))
+	names := make([]*node, len(items))
+	for i, item := range items {
+		t, err := parseValType(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		types[i] = t
+	}
+
+	return types, names, nil
+}
+
+// parseTypeUse parses an optional `(type x)` reference, followed by
+// the optional `(param ...)` and `(result ...)` declarations.
+func parseTypeUse(c *cursor) (typeUse, error) {
+	tu := typeUse{pos: c.pos}
+	if n := c.peek(); n != nil {
+		tu.pos = n.pos
+	}
+
+	if c.peekKeyword("type") {
+		tc := newCursor(c.next())
+		if tu.ref = tc.next(); tu.ref == nil {
+			return tu, tc.expected(tu.ref, "type index")
+		}
+		if err := tc.expectEnd(); err != nil {
+			return tu, err
+		}
+	}
+
+	for c.peekKeyword("param") {
+		params, names, err := parseValTypes(c.next(), true)
+		if err != nil {
+			return tu, err
+		}
+		tu.params = append(tu.params, params...)
+		tu.paramNames = append(tu.paramNames, names...)
+	}
+
+	for c.peekKeyword("result") {
+		results, _, err := parseValTypes(c.next(), false)
+		if err != nil {
+			return tu, err
+		}
+		tu.results = append(tu.results, results...)
+	}
+
+	return tu, nil
+}
+
+// resolveTypeUse returns the index of the referenced function type. An inline type
+// matching no type is implicitly defined, by appending it to the module types.
+func (m *module) resolveTypeUse(tu typeUse) (uint32, error) {
+	ft := funcType{params: tu.params, results: tu.results}
+
+	if tu.ref != nil {
+		idx, err := m.typeSpace.resolve(tu.ref)
+		if err != nil {
+			return 0, err
+		}
+		if (len(ft.params) > 0 || len(ft.results) > 0) && !m.types[idx].equal(ft) {
+			return 0, errorf(tu.pos, "inline function type doesn't match type %v", tu.ref)
+		}
+		return idx, nil
+	}
+
+	for i, t := range m.types {
+		if t.equal(ft) {
+			return uint32(i), nil
+		}
+	}
+
+	m.types = append(m.types, ft)
+	m.typeSpace.count++
+	return m.typeSpace.count - 1, nil
+}
+
+func parseLimits(c *cursor) (limits, error) {
+	n := c.next()
+	if n == nil || !n.isSymbol() {
+		return limits{}, c.expected(n, "limits")
+	}
+
+	var l limits
+	var ok bool
+	if l.min, ok = parseNat(n.atom); !ok {
+		return limits{}, c.expected(n, "limits")
+	}
+
+	if next := c.peek(); next != nil && next.isSymbol() {
+		if l.max, ok = parseNat(next.atom); ok {
+			c.next()
+			l.hasMax = true
+			if l.min > l.max {
+				return limits{}, errorf(n.pos, "size minimum must not be greater than maximum")
+			}
+		}
+	}
+
+	return l, nil
+}
+
+func isElemType(n *node) bool {
+	return n.isSymbol() && (n.atom == "funcref" || n.atom == "anyfunc")
+}
+
+func parseTableType(c *cursor) (limits, error) {
+	l, err := parseLimits(c)
+	if err != nil {
+		return limits{}, err
+	}
+
+	if n := c.next(); n == nil || !isElemType(n) {
+		return limits{}, c.expected(n, "table element type `funcref`")
+	}
+
+	return l, nil
+}
+
+func parseGlobalType(c *cursor) (globalType, error) {
+	n := c.next()
+	if n == nil {
+		return globalType{}, c.expected(n, "global type")
+	}
+
+	if n.isKeyword("mut") {
+		if len(n.list) != 2 {
+			return globalType{}, errorf(n.pos, "expected a single value type for a mutable global")
+		}
+		t, err := parseValType(n.list[1])
+		return globalType{typ: t, mutable: true}, err
+	}
+
+	t, err := parseValType(n)
+	return globalType{typ: t}, err
+}
+
+// resolve resolves the references, and compiles the function bodies and the constant expressions.
+func (m *module) resolve() error {
+	for _, fn := range m.pending {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	for _, g := range m.globals {
+		code, err := m.compileConstExpr(g.init)
+		if err != nil {
+			return err
+		}
+		g.code = code
+	}
+
+	names := make(map[string]bool)
+	for _, e := range m.exports {
+		if names[e.name] {
+			return fmt.Errorf("duplicate export %q", e.name)
+		}
+		names[e.name] = true
+
+		if e.ref == nil {
+			continue
+		}
+
+		var err error
+		switch e.kind {
+		case kindFunc:
+			e.index, err = m.funcSpace.resolve(e.ref)
+		case kindTable:
+			e.index, err = m.tableSpace.resolve(e.ref)
+		case kindMemory:
+			e.index, err = m.memorySpace.resolve(e.ref)
+		default:
+			e.index, err = m.globalSpace.resolve(e.ref)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if m.start != nil {
+		idx, err := m.funcSpace.resolve(m.start)
+		if err != nil {
+			return err
+		}
+		m.startIdx = idx
+	}
+
+	for _, elem := range m.elems {
+		if err := m.resolveSegment(elem); err != nil {
+			return err
+		}
+
+		for _, ref := range elem.funcRefs {
+			idx, err := m.funcSpace.resolve(ref)
+			if err != nil {
+				return err
+			}
+			elem.funcs = append(elem.funcs, idx)
+		}
+	}
+
+	for _, data := range m.datas {
+		if err := m.resolveSegment(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *module) resolveSegment(s *segment) error {
+	if s.code != nil {
+		return nil
+	}
+
+	code, err := m.compileConstExpr(s.offset)
+	s.code = code
+	return err
+}
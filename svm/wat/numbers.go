@@ -0,0 +1,163 @@
+package wat
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// splitSign splits the optional sign off a number literal.
+func splitSign(s string) (neg bool, rest string) {
+	switch {
+	case strings.HasPrefix(s, "-"):
+		return true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		return false, s[1:]
+	default:
+		return false, s
+	}
+}
+
+// parseDigits parses an unsigned decimal or `0x` hexadecimal literal,
+// whose digits may be separated by underscores.
+func parseDigits(s string) (uint64, bool) {
+	base := 10
+	if strings.HasPrefix(s, "0x") {
+		base, s = 16, s[2:]
+	}
+	if s == "" || strings.HasPrefix(s, "_") || strings.HasSuffix(s, "_") ||
+		strings.Contains(s, "__") || strings.ContainsAny(s, "+-") {
+		return 0, false
+	}
+
+	v, err := strconv.ParseUint(strings.Replace(s, "_", "", -1), base, 64)
+	return v, err == nil
+}
+
+// parseNat parses an unsigned 32-bit literal, used for indices, offsets and limits.
+func parseNat(s string) (uint32, bool) {
+	v, ok := parseDigits(s)
+	if !ok || v > math.MaxUint32 {
+		return 0, false
+	}
+
+	return uint32(v), true
+}
+
+// parseInt parses an integer literal of the given bit size (32 or 64), which may be
+// written either as a signed or as an unsigned value, and returns its signed value.
+func parseInt(s string, bitSize uint) (int64, bool) {
+	neg, digits := splitSign(s)
+	v, ok := parseDigits(digits)
+	if !ok {
+		return 0, false
+	}
+
+	if neg {
+		if v > 1<<(bitSize-1) {
+			return 0, false
+		}
+		return -int64(v), true
+	}
+
+	if bitSize == 32 {
+		if v > math.MaxUint32 {
+			return 0, false
+		}
+		return int64(int32(uint32(v))), true
+	}
+
+	return int64(v), true
+}
+
+// parseFloat parses a floating-point literal of the given bit size (32 or 64),
+// and returns its IEEE 754 bits.
+func parseFloat(s string, bitSize int) (uint64, bool) {
+	neg, rest := splitSign(s)
+
+	var bits uint64
+	switch {
+	case rest == "inf":
+		bits = floatBits(math.Inf(1), bitSize)
+	case rest == "nan":
+		if bitSize == 32 {
+			bits = 0x7fc00000
+		} else {
+			bits = 0x7ff8000000000000
+		}
+	case strings.HasPrefix(rest, "nan:0x"):
+		payload, ok := parseDigits(rest[4:])
+		if bitSize == 32 {
+			if !ok || payload == 0 || payload >= 1<<23 {
+				return 0, false
+			}
+			bits = 0x7f800000 | payload
+		} else {
+			if !ok || payload == 0 || payload >= 1<<52 {
+				return 0, false
+			}
+			bits = 0x7ff0000000000000 | payload
+		}
+	default:
+		if !validFloatDigits(rest) {
+			return 0, false
+		}
+		rest = strings.Replace(rest, "_", "", -1)
+		if strings.HasPrefix(rest, "0x") && !strings.ContainsAny(rest, "pP") {
+			rest += "p0"
+		}
+
+		v, err := strconv.ParseFloat(rest, bitSize)
+		if err != nil {
+			return 0, false
+		}
+		bits = floatBits(v, bitSize)
+	}
+
+	if neg {
+		if bitSize == 32 {
+			bits |= 1 << 31
+		} else {
+			bits |= 1 << 63
+		}
+	}
+
+	return bits, true
+}
+
+func floatBits(v float64, bitSize int) uint64 {
+	if bitSize == 32 {
+		return uint64(math.Float32bits(float32(v)))
+	}
+
+	return math.Float64bits(v)
+}
+
+// validFloatDigits checks the characters of an unsigned decimal or hexadecimal float literal,
+// since strconv.ParseFloat accepts forms which aren't valid in the text format (e.g. "Inf").
+func validFloatDigits(s string) bool {
+	digits := "0123456789"
+	exp := "eE"
+	if strings.HasPrefix(s, "0x") {
+		digits, exp, s = "0123456789abcdefABCDEF", "pP", s[2:]
+	}
+
+	if s == "" || strings.IndexByte(digits, s[0]) < 0 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case strings.IndexByte(exp, c) >= 0:
+			// The exponent is always decimal, and may be signed.
+			_, rest := splitSign(s[i+1:])
+			return rest != "" && rest[0] != '_' && strings.Trim(rest, "0123456789_") == ""
+		case c == '.' || c == '_' || strings.IndexByte(digits, c) >= 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
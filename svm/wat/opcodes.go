@@ -0,0 +1,180 @@
+package wat
+
+import "strings"
+
+type valType byte
+
+const (
+	i32 valType = 0x7f
+	i64 valType = 0x7e
+	f32 valType = 0x7d
+	f64 valType = 0x7c
+)
+
+var valTypes = map[string]valType{
+	"i32": i32,
+	"i64": i64,
+	"f32": f32,
+	"f64": f64,
+}
+
+const (
+	funcRefType    = 0x70
+	funcTypeForm   = 0x60
+	emptyBlock     = 0x40
+	opEnd          = 0x0b
+	opElse         = 0x05
+	opCallIndirect = 0x11
+)
+
+// immediates is the kind of the immediate arguments of an instruction.
+type immediates int
+
+const (
+	immNone immediates = iota
+	immBlock
+	immElse
+	immEnd
+	immLabel
+	immLabelTable
+	immFunc
+	immCallIndirect
+	immLocal
+	immGlobal
+	immMemArg
+	immMemory
+	immI32
+	immI64
+	immF32
+	immF64
+)
+
+type instruction struct {
+	opcode byte
+	imm    immediates
+
+	// The natural alignment (log2 of the access size) of the memory instructions.
+	align uint32
+}
+
+// instructions maps the instructions names to their opcode and immediates kind.
+var instructions = map[string]instruction{
+	"unreachable":   {0x00, immNone, 0},
+	"nop":           {0x01, immNone, 0},
+	"block":         {0x02, immBlock, 0},
+	"loop":          {0x03, immBlock, 0},
+	"if":            {0x04, immBlock, 0},
+	"else":          {opElse, immElse, 0},
+	"end":           {opEnd, immEnd, 0},
+	"br":            {0x0c, immLabel, 0},
+	"br_if":         {0x0d, immLabel, 0},
+	"br_table":      {0x0e, immLabelTable, 0},
+	"return":        {0x0f, immNone, 0},
+	"call":          {0x10, immFunc, 0},
+	"call_indirect": {opCallIndirect, immCallIndirect, 0},
+
+	"drop":   {0x1a, immNone, 0},
+	"select": {0x1b, immNone, 0},
+
+	"local.get":  {0x20, immLocal, 0},
+	"local.set":  {0x21, immLocal, 0},
+	"local.tee":  {0x22, immLocal, 0},
+	"global.get": {0x23, immGlobal, 0},
+	"global.set": {0x24, immGlobal, 0},
+
+	"i32.load":     {0x28, immMemArg, 2},
+	"i64.load":     {0x29, immMemArg, 3},
+	"f32.load":     {0x2a, immMemArg, 2},
+	"f64.load":     {0x2b, immMemArg, 3},
+	"i32.load8_s":  {0x2c, immMemArg, 0},
+	"i32.load8_u":  {0x2d, immMemArg, 0},
+	"i32.load16_s": {0x2e, immMemArg, 1},
+	"i32.load16_u": {0x2f, immMemArg, 1},
+	"i64.load8_s":  {0x30, immMemArg, 0},
+	"i64.load8_u":  {0x31, immMemArg, 0},
+	"i64.load16_s": {0x32, immMemArg, 1},
+	"i64.load16_u": {0x33, immMemArg, 1},
+	"i64.load32_s": {0x34, immMemArg, 2},
+	"i64.load32_u": {0x35, immMemArg, 2},
+	"i32.store":    {0x36, immMemArg, 2},
+	"i64.store":    {0x37, immMemArg, 3},
+	"f32.store":    {0x38, immMemArg, 2},
+	"f64.store":    {0x39, immMemArg, 3},
+	"i32.store8":   {0x3a, immMemArg, 0},
+	"i32.store16":  {0x3b, immMemArg, 1},
+	"i64.store8":   {0x3c, immMemArg, 0},
+	"i64.store16":  {0x3d, immMemArg, 1},
+	"i64.store32":  {0x3e, immMemArg, 2},
+	"memory.size":  {0x3f, immMemory, 0},
+	"memory.grow":  {0x40, immMemory, 0},
+
+	"i32.const": {0x41, immI32, 0},
+	"i64.const": {0x42, immI64, 0},
+	"f32.const": {0x43, immF32, 0},
+	"f64.const": {0x44, immF64, 0},
+}
+
+// numericInstructions lists the immediate-free numeric instructions, starting at opcode 0x45.
+var numericInstructions = []string{
+	"i32.eqz", "i32.eq", "i32.ne", "i32.lt_s", "i32.lt_u", "i32.gt_s", "i32.gt_u",
+	"i32.le_s", "i32.le_u", "i32.ge_s", "i32.ge_u",
+
+	"i64.eqz", "i64.eq", "i64.ne", "i64.lt_s", "i64.lt_u", "i64.gt_s", "i64.gt_u",
+	"i64.le_s", "i64.le_u", "i64.ge_s", "i64.ge_u",
+
+	"f32.eq", "f32.ne", "f32.lt", "f32.gt", "f32.le", "f32.ge",
+	"f64.eq", "f64.ne", "f64.lt", "f64.gt", "f64.le", "f64.ge",
+
+	"i32.clz", "i32.ctz", "i32.popcnt", "i32.add", "i32.sub", "i32.mul", "i32.div_s", "i32.div_u",
+	"i32.rem_s", "i32.rem_u", "i32.and", "i32.or", "i32.xor", "i32.shl", "i32.shr_s", "i32.shr_u",
+	"i32.rotl", "i32.rotr",
+
+	"i64.clz", "i64.ctz", "i64.popcnt", "i64.add", "i64.sub", "i64.mul", "i64.div_s", "i64.div_u",
+	"i64.rem_s", "i64.rem_u", "i64.and", "i64.or", "i64.xor", "i64.shl", "i64.shr_s", "i64.shr_u",
+	"i64.rotl", "i64.rotr",
+
+	"f32.abs", "f32.neg", "f32.ceil", "f32.floor", "f32.trunc", "f32.nearest", "f32.sqrt",
+	"f32.add", "f32.sub", "f32.mul", "f32.div", "f32.min", "f32.max", "f32.copysign",
+
+	"f64.abs", "f64.neg", "f64.ceil", "f64.floor", "f64.trunc", "f64.nearest", "f64.sqrt",
+	"f64.add", "f64.sub", "f64.mul", "f64.div", "f64.min", "f64.max", "f64.copysign",
+
+	"i32.wrap_i64", "i32.trunc_f32_s", "i32.trunc_f32_u", "i32.trunc_f64_s", "i32.trunc_f64_u",
+	"i64.extend_i32_s", "i64.extend_i32_u", "i64.trunc_f32_s", "i64.trunc_f32_u",
+	"i64.trunc_f64_s", "i64.trunc_f64_u",
+	"f32.convert_i32_s", "f32.convert_i32_u", "f32.convert_i64_s", "f32.convert_i64_u",
+	"f32.demote_f64",
+	"f64.convert_i32_s", "f64.convert_i32_u", "f64.convert_i64_s", "f64.convert_i64_u",
+	"f64.promote_f32",
+	"i32.reinterpret_f32", "i64.reinterpret_f64", "f32.reinterpret_i32", "f64.reinterpret_i64",
+}
+
+// legacyNames maps the instructions names used before the 1.0 spec to their current names.
+var legacyNames = map[string]string{
+	"get_local":      "local.get",
+	"set_local":      "local.set",
+	"tee_local":      "local.tee",
+	"get_global":     "global.get",
+	"set_global":     "global.set",
+	"current_memory": "memory.size",
+	"grow_memory":    "memory.grow",
+}
+
+func init() {
+	for i, name := range numericInstructions {
+		instructions[name] = instruction{opcode: byte(0x45 + i), imm: immNone}
+
+		// The conversions were named with a slash, e.g. "i32.trunc_s/f32" for "i32.trunc_f32_s".
+		parts := strings.Split(name, "_")
+		switch {
+		case len(parts) == 2 && valTypes[parts[1]] != 0:
+			legacyNames[parts[0]+"/"+parts[1]] = name
+		case len(parts) == 3 && valTypes[parts[1]] != 0:
+			legacyNames[parts[0]+"_"+parts[2]+"/"+parts[1]] = name
+		}
+	}
+
+	for legacy, name := range legacyNames {
+		instructions[legacy] = instructions[name]
+	}
+}
@@ -0,0 +1,242 @@
+package wat
+
+import (
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCompile_Templates(t *testing.T) {
+	for _, name := range []string{"storage_template", "counter_template"} {
+		t.Run(name, func(t *testing.T) {
+			req := require.New(t)
+
+			src, err := ioutil.ReadFile("../testdata/" + name + ".wat")
+			req.NoError(err)
+			expected, err := ioutil.ReadFile("../testdata/" + name + ".wasm")
+			req.NoError(err)
+
+			code, err := Compile(src)
+			req.NoError(err)
+			req.Equal(expected, code)
+		})
+	}
+}
+
+// compileBody compiles a module holding a single function without locals, and returns its code.
+func compileBody(req *require.Assertions, fn string) []byte {
+	code, err := Compile([]byte("(module (memory 1) (global $g (mut i32) (i32.const 0)) " + fn + ")"))
+	req.NoError(err)
+
+	// The code section is the last one, and holds a single entry: size, locals count (0), code.
+	code = code[len(header):]
+	for code[0] != sectionCode {
+		size, rest := readU32(code[1:])
+		code = rest[size:]
+	}
+	_, section := readU32(code[1:])
+	_, entry := readU32(section[1:])
+	req.Equal(byte(0), entry[0])
+	return entry[1:]
+}
+
+func readU32(b []byte) (uint32, []byte) {
+	var v uint32
+	for i, c := range b {
+		v |= uint32(c&0x7f) << (7 * uint(i))
+		if c&0x80 == 0 {
+			return v, b[i+1:]
+		}
+	}
+	panic("truncated LEB128")
+}
+
+func TestCompile_Instructions(t *testing.T) {
+	req := require.New(t)
+
+	flat := compileBody(req, `(func $f (param $a i32) (param $b i32) (result i32)
+		get_local $a
+		local.get 1
+		i32.add)`)
+	folded := compileBody(req, `(func $f (param $a i32) (param $b i32) (result i32)
+		(i32.add (local.get $a) (get_local $b)))`)
+	req.Equal([]byte{0x20, 0x00, 0x20, 0x01, 0x6a, opEnd}, flat)
+	req.Equal(flat, folded)
+
+	req.Equal([]byte{
+		0x02, emptyBlock, // block $out
+		0x03, emptyBlock, // loop $top
+		0x20, 0x00, 0x45, 0x0d, 0x01, // br_if $out (i32.eqz (local.get $x))
+		0x20, 0x00, 0x41, 0x01, 0x6b, 0x21, 0x00, // local.set $x (i32.sub ...)
+		0x0c, 0x00, // br $top
+		opEnd, opEnd, opEnd,
+	}, compileBody(req, `(func (param $x i32)
+		(block $out
+			(loop $top
+				(br_if $out (i32.eqz (local.get $x)))
+				(local.set $x (i32.sub (local.get $x) (i32.const 1)))
+				(br $top))))`))
+
+	req.Equal([]byte{
+		0x20, 0x00, 0x04, byte(i32), // if (result i32)
+		0x41, 0x01, opElse, 0x41, 0x02, opEnd, opEnd,
+	}, compileBody(req, `(func (param i32) (result i32)
+		(if (result i32) (local.get 0) (then (i32.const 1)) (else (i32.const 2))))`))
+	req.Equal(compileBody(req, `(func (param i32) (result i32)
+		local.get 0 if $l (result i32) i32.const 1 else $l i32.const 2 end $l)`),
+		compileBody(req, `(func (param i32) (result i32)
+		(if (result i32) (local.get 0) (then (i32.const 1)) (else (i32.const 2))))`))
+
+	req.Equal([]byte{
+		0x02, emptyBlock, 0x02, emptyBlock, 0x20, 0x00,
+		0x0e, 0x02, 0x00, 0x01, 0x02, // br_table 0 1 2 (2 is the function body)
+		opEnd, opEnd, opEnd,
+	}, compileBody(req, `(func (param i32)
+		block block (br_table 0 1 2 (local.get 0)) end end)`))
+
+	req.Equal([]byte{
+		0x41, 0x00, 0x28, 0x02, 0x00, 0x1a, // i32.load
+		0x41, 0x00, 0x41, 0x00, 0x29, 0x00, 0x10, // i64.load offset=16 align=1
+		0x3c, 0x00, 0x04, // i64.store8 offset=4
+		0x3f, 0x00, 0x40, 0x00, 0x1a, // memory.size, memory.grow, drop
+		0x23, 0x00, 0x24, 0x00, // global.get, global.set
+		opEnd,
+	}, compileBody(req, `(func
+		(drop (i32.load (i32.const 0)))
+		(i64.store8 offset=4 (i32.const 0) (i64.load offset=16 align=1 (i32.const 0)))
+		(drop (grow_memory (current_memory)))
+		(global.set $g (get_global $g)))`))
+
+	req.Equal([]byte{
+		0x41, 0x7f, 0x1a, // i32.const -1
+		0x41, 0x7f, 0x1a, // i32.const 0xffffffff
+		0x41, 0x80, 0x01, 0x1a, // i32.const 128
+		0x42, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x7f, 0x1a, // i64.const -0x8000_0000_0000_0000
+		0x43, 0x00, 0x00, 0xc0, 0x3f, 0x1a, // f32.const 1.5
+		0x44, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0xbf, 0x1a, // f64.const -0x1p0
+		0x43, 0x00, 0x00, 0x80, 0x7f, 0x1a, // f32.const inf
+		0x43, 0x01, 0x00, 0x80, 0xff, 0x1a, // f32.const -nan:0x1
+		0x41, 0x00, 0xa7, 0xb7, 0x1a, // f64.convert_s/i32 (i32.wrap/i64 ...)
+		opEnd,
+	}, compileBody(req, `(func
+		(drop (i32.const -1))
+		(drop (i32.const 0xffff_ffff))
+		(drop (i32.const 128))
+		(drop (i64.const -0x8000_0000_0000_0000))
+		(drop (f32.const 1.5))
+		(drop (f64.const -0x1p0))
+		(drop (f32.const inf))
+		(drop (f32.const -nan:0x1))
+		(drop (f64.convert_s/i32 (i32.wrap/i64 (i32.const 0)))))`))
+}
+
+func TestCompile_Locals(t *testing.T) {
+	req := require.New(t)
+
+	code, err := Compile([]byte(`(module
+		(type $t (func (param i32 i64)))
+		(func (type $t) (local $a i32) (local i32 f64) (local $b f64)
+			(local.set $b (local.get 4))
+			(local.set $a (local.get 2))))`))
+	req.NoError(err)
+
+	// The locals are grouped by type: 2 x i32, 2 x f64.
+	req.Equal([]byte{
+		sectionCode, 0x10, 0x01, 0x0e,
+		0x02, 0x02, byte(i32), 0x02, byte(f64),
+		0x20, 0x04, 0x21, 0x05, 0x20, 0x02, 0x21, 0x02, opEnd,
+	}, code[len(code)-18:])
+}
+
+func TestCompile_Module(t *testing.T) {
+	req := require.New(t)
+
+	code, err := Compile([]byte(`
+		;; A module using all the sections.
+		(module $m
+			(import "env" "log" (func $log (param i32)))
+			(type $void (func))
+			(table 2 funcref)
+			(memory (export "memory") 1 2)
+			(global $count (mut i32) (i32.const 7))
+			(export "count" (global $count))
+			(start $init)
+			(elem (i32.const 0) $init $log)
+			(data (i32.const 8) "hi" "\01")
+			(func $init (type $void)
+				(; call the import ;)
+				(call $log (global.get $count)))
+			(func (export "dispatch") (param i32)
+				(call_indirect (param i32) (i32.const 1) (local.get 0))))`))
+	req.NoError(err)
+
+	req.Equal([]byte{
+		0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00,
+		sectionType, 0x08, 0x02, 0x60, 0x00, 0x00, 0x60, 0x01, byte(i32), 0x00,
+		sectionImport, 0x0b, 0x01, 0x03, 'e', 'n', 'v', 0x03, 'l', 'o', 'g', kindFunc, 0x01,
+		sectionFunction, 0x03, 0x02, 0x00, 0x01,
+		sectionTable, 0x04, 0x01, funcRefType, 0x00, 0x02,
+		sectionMemory, 0x04, 0x01, 0x01, 0x01, 0x02,
+		sectionGlobal, 0x06, 0x01, byte(i32), 0x01, 0x41, 0x07, opEnd,
+		sectionExport, 0x1d, 0x03,
+		0x06, 'm', 'e', 'm', 'o', 'r', 'y', kindMemory, 0x00,
+		0x05, 'c', 'o', 'u', 'n', 't', kindGlobal, 0x00,
+		0x08, 'd', 'i', 's', 'p', 'a', 't', 'c', 'h', kindFunc, 0x02,
+		sectionStart, 0x01, 0x01,
+		sectionElement, 0x08, 0x01, 0x00, 0x41, 0x00, opEnd, 0x02, 0x01, 0x00,
+		sectionCode, 0x12, 0x02,
+		0x06, 0x00, 0x23, 0x00, 0x10, 0x00, opEnd,
+		0x09, 0x00, 0x41, 0x01, 0x20, 0x00, 0x11, 0x01, 0x00, opEnd,
+		sectionData, 0x09, 0x01, 0x00, 0x41, 0x08, opEnd, 0x03, 'h', 'i', 0x01,
+	}, code)
+}
+
+func TestCompile_Abbreviations(t *testing.T) {
+	req := require.New(t)
+
+	abbreviated, err := Compile([]byte(`
+		(func $f (import "env" "f"))
+		(table funcref (elem $f $f))
+		(memory (data "abc"))`))
+	req.NoError(err)
+
+	expanded, err := Compile([]byte(`(module
+		(import "env" "f" (func $f))
+		(table 2 2 anyfunc)
+		(memory 1 1)
+		(elem (offset (i32.const 0)) func $f $f)
+		(data (memory 0) (offset i32.const 0) "abc"))`))
+	req.NoError(err)
+
+	req.Equal(expanded, abbreviated)
+}
+
+func TestCompile_Errors(t *testing.T) {
+	req := require.New(t)
+
+	tests := []struct {
+		src string
+		err string
+	}{
+		{`(module (func`, "1:9: unclosed `(`"},
+		{`(module "\x")`, "1:10: invalid escape sequence `\\x`"},
+		{`(module (func $f) (func $f))`, "1:25: duplicate function $f"},
+		{`(module (func (call $g)))`, "1:21: unknown function $g"},
+		{`(module (func i32.foo))`, "1:15: unknown instruction `i32.foo`"},
+		{`(module (func (local.get 0)))`, "1:26: local index 0 is out of range"},
+		{`(module (func (br $l)))`, "1:19: unknown label $l"},
+		{`(module (func block))`, "1:15: unclosed `block`"},
+		{`(module (func end))`, "1:15: unexpected `end`"},
+		{`(module (func (i32.const 0x1_0000_0000)))`, "1:26: invalid i32 literal `0x1_0000_0000`"},
+		{`(module (func (i32.load align=8 (i32.const 0))))`, "1:25: alignment must not be larger than natural"},
+		{`(module (func) (import "env" "f" (func)))`, "1:16: imports must occur before all non-import definitions"},
+		{`(module (func (export "f")) (func (export "f")))`, `duplicate export "f"`},
+		{`(module (func (param i64)) (memory 2 1))`, "1:36: size minimum must not be greater than maximum"},
+		{`(module (foo))`, "1:9: unknown module field `(foo)`"},
+	}
+
+	for _, test := range tests {
+		_, err := Compile([]byte(test.src))
+		req.EqualError(err, "failed to compile wat: "+test.err, test.src)
+	}
+}